@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"io"
 	"net/http"
+	"time"
 )
 
 // Client manages communication with the Turso API
@@ -19,6 +20,12 @@ type Client struct {
 	Group          groupService
 	Database       databaseService
 	DatabaseTokens databaseTokensService
+	GroupTokens    groupTokensService
+	Locations      locationService
+	// locations is the cached location catalog used to validate location codes
+	locations locationCatalog
+	// limiter limits the rate of requests, requests are not limited when nil
+	limiter RateLimiter
 }
 
 type service struct {
@@ -30,6 +37,12 @@ type HTTPRequestDoer interface {
 	Do(req *http.Request) (*http.Response, error)
 }
 
+// RateLimiter limits the rate of requests made by the client, it is satisfied by *rate.Limiter from golang.org/x/time/rate
+type RateLimiter interface {
+	// Wait blocks until a request is allowed or the context is done
+	Wait(ctx context.Context) error
+}
+
 // Client returns the http client
 func (c *Client) Client() HTTPRequestDoer {
 	return c.client
@@ -56,29 +69,88 @@ func NewClient(c Config) (*Client, error) {
 	client.Database = (*DatabaseService)(&client.common)
 	client.Group = (*GroupService)(&client.common)
 	client.DatabaseTokens = (*DatabaseTokensService)(&client.common)
+	client.GroupTokens = (*GroupTokensService)(&client.common)
+	client.Locations = (*LocationService)(&client.common)
 
 	return client, nil
 }
 
+// SetRateLimiter sets the rate limiter used for every request made by the client
+func (c *Client) SetRateLimiter(limiter RateLimiter) {
+	c.limiter = limiter
+}
+
+// pollInterval returns the configured poll interval, or the default when not set
+func (c *Client) pollInterval() time.Duration {
+	if c.cfg == nil || c.cfg.PollInterval <= 0 {
+		return defaultPollInterval
+	}
+
+	return c.cfg.PollInterval
+}
+
+// RequestBody is a request body that is streamed as is instead of being encoded as JSON,
+// e.g. a file upload or a multipart body
+type RequestBody struct {
+	// Reader is the body of the request
+	Reader io.Reader
+	// ContentType is the content type of the body, defaults to application/octet-stream
+	ContentType string
+	// ContentLength is the length of the body, the body is sent chunked when zero or less
+	ContentLength int64
+}
+
 // DoRequest performs an HTTP request and returns the response
+// the data is encoded as JSON unless it is a *RequestBody, which is streamed as is
 func (c *Client) DoRequest(ctx context.Context, method string, url string, data interface{}) (*http.Response, error) {
+	return c.doRequestWithToken(ctx, method, url, c.cfg.Token, data)
+}
+
+// doRequestWithToken performs an HTTP request authenticated with the given token and returns the response
+func (c *Client) doRequestWithToken(ctx context.Context, method string, url string, token string, data interface{}) (*http.Response, error) {
 	var bodyReader io.Reader
 
-	buf, err := json.Marshal(data)
-	if err != nil {
-		return nil, err
-	}
+	contentType := "application/json"
+	contentLength := int64(-1)
 
-	bodyReader = bytes.NewReader(buf)
+	if body, ok := data.(*RequestBody); ok {
+		bodyReader = body.Reader
+
+		contentType = body.ContentType
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+
+		if body.ContentLength > 0 {
+			contentLength = body.ContentLength
+		}
+	} else {
+		buf, err := json.Marshal(data)
+		if err != nil {
+			return nil, err
+		}
+
+		bodyReader = bytes.NewReader(buf)
+	}
 
 	req, err := http.NewRequestWithContext(ctx, method, url, bodyReader)
 	if err != nil {
 		return nil, err
 	}
 
+	if contentLength >= 0 {
+		req.ContentLength = contentLength
+	}
+
 	// Add Headers
-	req.Header.Add("Authorization", "Bearer "+c.cfg.Token)
-	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Authorization", "Bearer "+token)
+	req.Header.Add("Content-Type", contentType)
+
+	if c.limiter != nil {
+		if err := c.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
 
 	return c.client.Do(req.WithContext(ctx))
 }
@@ -1,5 +1,9 @@
 package turso
 
+import "time"
+
+const defaultPollInterval = 2 * time.Second
+
 // Config is the configuration for the turso client
 type Config struct {
 	// Token is the token used to authenticate with the turso API
@@ -8,4 +12,8 @@ type Config struct {
 	BaseURL string `json:"baseUrl" koanf:"baseUrl" jsonschema:"required" default:"https://api.turso.tech"`
 	// OrgName is the name of the organization to use for the turso API
 	OrgName string `json:"orgName" koanf:"orgName" jsonschema:"required"`
+	// ValidateLocations validates location codes against the cached Turso location catalog before creating groups or adding locations
+	ValidateLocations bool `json:"validateLocations" koanf:"validateLocations" default:"false"`
+	// PollInterval is the interval used when waiting for a resource to reach the desired state
+	PollInterval time.Duration `json:"pollInterval" koanf:"pollInterval" default:"2s"`
 }
@@ -0,0 +1,132 @@
+package turso
+
+import (
+	"fmt"
+	"maps"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+const (
+	databaseHostnameFormat = "%s-%s.turso.io"
+
+	// EnvDatabaseURL is the environment variable holding the libsql URL of the database
+	EnvDatabaseURL = "TURSO_DATABASE_URL"
+	// EnvAuthToken is the environment variable holding the database token
+	EnvAuthToken = "TURSO_AUTH_TOKEN"
+)
+
+// ConnectionInfo describes how to connect to a database
+type ConnectionInfo struct {
+	// Hostname is the hostname of the database
+	Hostname string
+	// Token is the database token, it is attached to the DSN and environment when set
+	Token string
+	// Instances are the instances of the database used for per instance URLs
+	Instances []Instance
+}
+
+// NewConnectionInfo returns the connection info of the database
+func NewConnectionInfo(db *Database) *ConnectionInfo {
+	return &ConnectionInfo{Hostname: db.Hostname}
+}
+
+// NewConnectionInfoFromName returns the connection info of a database from its name and organization
+// without looking up the database
+func NewConnectionInfoFromName(dbName, orgName string) (*ConnectionInfo, error) {
+	if err := validateDatabaseName(dbName); err != nil {
+		return nil, err
+	}
+
+	if orgName == "" {
+		return nil, newMissingRequiredFieldError("orgName")
+	}
+
+	return &ConnectionInfo{Hostname: fmt.Sprintf(databaseHostnameFormat, dbName, strings.ToLower(orgName))}, nil
+}
+
+// WithToken returns a copy of the connection info using the token
+func (ci ConnectionInfo) WithToken(token string) *ConnectionInfo {
+	ci.Token = token
+
+	return &ci
+}
+
+// WithInstances returns a copy of the connection info with the instances of the database
+func (ci ConnectionInfo) WithInstances(instances []Instance) *ConnectionInfo {
+	ci.Instances = slices.Clone(instances)
+
+	return &ci
+}
+
+// LibsqlURL returns the libsql URL of the database, e.g. libsql://my-db-my-org.turso.io
+func (ci *ConnectionInfo) LibsqlURL() string {
+	return hostURL("libsql", ci.Hostname)
+}
+
+// HTTPURL returns the HTTPS URL of the database
+func (ci *ConnectionInfo) HTTPURL() string {
+	return hostURL("https", ci.Hostname)
+}
+
+// WebSocketURL returns the secure WebSocket URL of the database
+func (ci *ConnectionInfo) WebSocketURL() string {
+	return hostURL("wss", ci.Hostname)
+}
+
+// InstanceURLs returns the libsql URLs of the instances of the database by instance name
+func (ci *ConnectionInfo) InstanceURLs() map[string]string {
+	out := make(map[string]string, len(ci.Instances))
+
+	for _, instance := range ci.Instances {
+		out[instance.Name] = hostURL("libsql", instance.Hostname)
+	}
+
+	return out
+}
+
+// DSN returns the libsql URL of the database with the token attached as the authToken query parameter
+func (ci *ConnectionInfo) DSN() string {
+	u := url.URL{Scheme: "libsql", Host: ci.Hostname}
+
+	if ci.Token != "" {
+		u.RawQuery = url.Values{"authToken": {ci.Token}}.Encode()
+	}
+
+	return u.String()
+}
+
+// Env returns the environment variables used by the libsql clients to connect to the database
+// the token is only included when set
+func (ci *ConnectionInfo) Env() map[string]string {
+	env := map[string]string{
+		EnvDatabaseURL: ci.LibsqlURL(),
+	}
+
+	if ci.Token != "" {
+		env[EnvAuthToken] = ci.Token
+	}
+
+	return env
+}
+
+// Environ returns the environment variables in KEY=value form sorted by key, e.g. to write a .env file
+func (ci *ConnectionInfo) Environ() []string {
+	env := ci.Env()
+
+	out := make([]string, 0, len(env))
+
+	for _, key := range slices.Sorted(maps.Keys(env)) {
+		out = append(out, key+"="+env[key])
+	}
+
+	return out
+}
+
+// hostURL returns the URL of the host with the scheme
+func hostURL(scheme, hostname string) string {
+	u := url.URL{Scheme: scheme, Host: hostname}
+
+	return u.String()
+}
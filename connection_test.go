@@ -0,0 +1,49 @@
+package turso
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConnectionInfo(t *testing.T) {
+	ci := NewConnectionInfo(&Database{Name: "my-db", Hostname: "my-db-meow.turso.io"})
+
+	assert.Equal(t, "libsql://my-db-meow.turso.io", ci.LibsqlURL())
+	assert.Equal(t, "https://my-db-meow.turso.io", ci.HTTPURL())
+	assert.Equal(t, "wss://my-db-meow.turso.io", ci.WebSocketURL())
+	assert.Equal(t, "libsql://my-db-meow.turso.io", ci.DSN())
+	assert.Equal(t, map[string]string{EnvDatabaseURL: "libsql://my-db-meow.turso.io"}, ci.Env())
+	assert.Empty(t, ci.InstanceURLs())
+
+	withToken := ci.WithToken("a+b/c=")
+	assert.Empty(t, ci.Token)
+	assert.Equal(t, "libsql://my-db-meow.turso.io?authToken=a%2Bb%2Fc%3D", withToken.DSN())
+	assert.Equal(t, []string{
+		"TURSO_AUTH_TOKEN=a+b/c=",
+		"TURSO_DATABASE_URL=libsql://my-db-meow.turso.io",
+	}, withToken.Environ())
+
+	withInstances := withToken.WithInstances([]Instance{
+		{Name: "ord", Hostname: "ord-my-db-meow.turso.io"},
+		{Name: "ams", Hostname: "ams-my-db-meow.turso.io"},
+	})
+	assert.Equal(t, "a+b/c=", withInstances.Token)
+	assert.Equal(t, map[string]string{
+		"ord": "libsql://ord-my-db-meow.turso.io",
+		"ams": "libsql://ams-my-db-meow.turso.io",
+	}, withInstances.InstanceURLs())
+}
+
+func TestNewConnectionInfoFromName(t *testing.T) {
+	ci, err := NewConnectionInfoFromName("my-db", "Meow")
+	require.NoError(t, err)
+	assert.Equal(t, "my-db-meow.turso.io", ci.Hostname)
+
+	_, err = NewConnectionInfoFromName("My DB", "meow")
+	require.ErrorIs(t, err, ErrInvalidDatabaseName)
+
+	_, err = NewConnectionInfoFromName("my-db", "")
+	assert.ErrorContains(t, err, (&MissingRequiredFieldError{RequiredField: "orgName"}).Error())
+}
@@ -4,14 +4,25 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
+	"net/url"
 	"regexp"
+	"time"
 )
 
 const (
-	databaseEndpoint = "v1/organizations/%s/databases"
-	maxNameLength    = 32
-	regexName        = "^[a-z0-9-]+$"
+	databaseEndpoint         = "v1/organizations/%s/databases"
+	databaseConfigEndpoint   = databaseEndpoint + "/%s/configuration"
+	databaseUsageEndpoint    = databaseEndpoint + "/%s/usage"
+	databaseStatsEndpoint    = databaseEndpoint + "/%s/stats"
+	instancesEndpoint        = databaseEndpoint + "/%s/instances"
+	databaseTransferEndpoint = databaseEndpoint + "/%s/transfer"
+	maxNameLength            = MaxDatabaseNameLength
+	regexName                = "^[a-z0-9-]+$"
+
+	// MaxDatabaseNameLength is the maximum length of a database name
+	MaxDatabaseNameLength = 32
 )
 
 // DatabaseService is the interface for the Turso API database endpoint
@@ -20,12 +31,30 @@ type DatabaseService service
 type databaseService interface {
 	// ListDatabases lists all databases in the organization
 	ListDatabases(ctx context.Context) (*ListDatabaseResponse, error)
+	// Databases returns an iterator over the databases in the organization matching the request filters
+	Databases(ctx context.Context, req ListDatabasesRequest) iter.Seq2[*Database, error]
 	// CreateDatabase creates a new database
 	CreateDatabase(ctx context.Context, req CreateDatabaseRequest) (*CreateDatabaseResponse, error)
 	// GetDatabase gets a database by name
 	GetDatabase(ctx context.Context, dbName string) (*GetDatabaseResponse, error)
 	// DeleteDatabase deletes a database by name
 	DeleteDatabase(ctx context.Context, dbName string) (*DeleteDatabaseResponse, error)
+	// GetDatabaseConfig gets the configuration of a database by name
+	GetDatabaseConfig(ctx context.Context, dbName string) (*DatabaseConfig, error)
+	// UpdateDatabaseConfig updates the configuration of a database
+	UpdateDatabaseConfig(ctx context.Context, req UpdateDatabaseConfigRequest) (*DatabaseConfig, error)
+	// GetDatabaseUsage gets the usage of a database between from and to
+	GetDatabaseUsage(ctx context.Context, dbName string, from, to time.Time) (*GetDatabaseUsageResponse, error)
+	// GetDatabaseStats gets the query statistics of a database
+	GetDatabaseStats(ctx context.Context, dbName string) (*GetDatabaseStatsResponse, error)
+	// ListInstances lists all instances of a database
+	ListInstances(ctx context.Context, dbName string) (*ListInstancesResponse, error)
+	// GetInstance gets an instance of a database by name
+	GetInstance(ctx context.Context, dbName, instanceName string) (*GetInstanceResponse, error)
+	// DeleteInstance deletes an instance of a database by name
+	DeleteInstance(ctx context.Context, dbName, instanceName string) error
+	// TransferDatabase transfers a database to another organization
+	TransferDatabase(ctx context.Context, dbName, targetOrg string) (*Database, error)
 }
 
 // Database is the struct for the Turso Database object
@@ -103,6 +132,102 @@ type CreateDatabaseRequest struct {
 	// Name is the name of the database
 	// Must contain only lowercase letters, numbers, dashes. No longer than 32 characters.
 	Name string `json:"name"`
+	// Seed is the source the database is created from, the database is created empty when not set
+	Seed *DatabaseSeed `json:"seed,omitempty"`
+	// SizeLimit is the maximum size of the database, e.g. 256mb
+	SizeLimit string `json:"size_limit,omitempty"`
+	// Schema is the name of the parent schema database that owns the schema for this database
+	Schema string `json:"schema,omitempty"`
+}
+
+// DatabaseConfig is the struct for the Turso API database configuration
+type DatabaseConfig struct {
+	// SizeLimit is the maximum size of the database, e.g. 256mb, empty when there is no limit
+	SizeLimit string `json:"size_limit"`
+	// AllowAttach is true if the database can be attached to other databases
+	AllowAttach bool `json:"allow_attach"`
+	// BlockReads is true if reads are blocked
+	BlockReads bool `json:"block_reads"`
+	// BlockWrites is true if writes are blocked
+	BlockWrites bool `json:"block_writes"`
+	// DeleteProtection prevents the database from being deleted when enabled
+	DeleteProtection bool `json:"delete_protection"`
+}
+
+// UpdateDatabaseConfigRequest is the struct for the Turso API database configuration update request
+// only the fields that are set will be updated
+type UpdateDatabaseConfigRequest struct {
+	// DatabaseName is the name of the database to update
+	DatabaseName string `json:"-"`
+	// SizeLimit is the maximum size of the database, e.g. 256mb
+	SizeLimit *string `json:"size_limit,omitempty"`
+	// AllowAttach is true if the database can be attached to other databases
+	AllowAttach *bool `json:"allow_attach,omitempty"`
+	// BlockReads is true if reads are blocked
+	BlockReads *bool `json:"block_reads,omitempty"`
+	// BlockWrites is true if writes are blocked
+	BlockWrites *bool `json:"block_writes,omitempty"`
+	// DeleteProtection prevents the database from being deleted when enabled
+	DeleteProtection *bool `json:"delete_protection,omitempty"`
+}
+
+// GetDatabaseUsageResponse is the struct for the Turso API database usage response
+type GetDatabaseUsageResponse struct {
+	Database DatabaseUsage `json:"database"`
+}
+
+// QueryStats is the struct for the Turso API query statistics object
+type QueryStats struct {
+	// Query is the SQL statement
+	Query string `json:"query"`
+	// RowsRead is the number of rows read by the query
+	RowsRead int64 `json:"rows_read"`
+	// RowsWritten is the number of rows written by the query
+	RowsWritten int64 `json:"rows_written"`
+}
+
+// GetDatabaseStatsResponse is the struct for the Turso API database stats response
+type GetDatabaseStatsResponse struct {
+	// TopQueries are the queries with the most rows read or written
+	TopQueries []QueryStats `json:"top_queries"`
+}
+
+// InstanceType is the type of a database instance
+type InstanceType string
+
+const (
+	InstanceTypePrimary InstanceType = "primary"
+	InstanceTypeReplica InstanceType = "replica"
+)
+
+// Instance is the struct for the Turso Instance object
+type Instance struct {
+	// UUID is the ID of the instance
+	UUID string `json:"uuid"`
+	// Name is the name of the instance
+	Name string `json:"name"`
+	// Type is the type of the instance, primary or replica
+	Type InstanceType `json:"type"`
+	// Region is the location code of the instance
+	Region string `json:"region"`
+	// Hostname is the hostname of the instance
+	Hostname string `json:"hostname"`
+}
+
+// ListInstancesResponse is the struct for the Turso API database instances list response
+type ListInstancesResponse struct {
+	Instances []Instance `json:"instances"`
+}
+
+// GetInstanceResponse is the struct for the Turso API database instance get response
+type GetInstanceResponse struct {
+	Instance Instance `json:"instance"`
+}
+
+// TransferDatabaseRequest is the struct for the Turso API database transfer request
+type TransferDatabaseRequest struct {
+	// Organization is the name of the organization to transfer the database to
+	Organization string `json:"organization"`
 }
 
 // getDatabaseEndpoint returns the endpoint for the Turso API database service
@@ -111,10 +236,56 @@ func getDatabaseEndpoint(baseURL, orgName string) string {
 	return fmt.Sprintf("%s/%s", baseURL, dbEndpoint)
 }
 
+// getDatabaseConfigEndpoint returns the endpoint for the Turso API database configuration service
+func getDatabaseConfigEndpoint(baseURL, orgName, dbName string) string {
+	configEndpoint := fmt.Sprintf(databaseConfigEndpoint, orgName, dbName)
+	return fmt.Sprintf("%s/%s", baseURL, configEndpoint)
+}
+
+// getDatabaseUsageEndpoint returns the endpoint for the Turso API database usage service
+// from and to are only added to the query when set
+func getDatabaseUsageEndpoint(baseURL, orgName, dbName string, from, to time.Time) string {
+	usageEndpoint := fmt.Sprintf("%s/%s", baseURL, fmt.Sprintf(databaseUsageEndpoint, orgName, dbName))
+
+	params := url.Values{}
+
+	if !from.IsZero() {
+		params.Set("from", from.UTC().Format(time.RFC3339))
+	}
+
+	if !to.IsZero() {
+		params.Set("to", to.UTC().Format(time.RFC3339))
+	}
+
+	if len(params) == 0 {
+		return usageEndpoint
+	}
+
+	return fmt.Sprintf("%s?%s", usageEndpoint, params.Encode())
+}
+
+// getDatabaseStatsEndpoint returns the endpoint for the Turso API database stats service
+func getDatabaseStatsEndpoint(baseURL, orgName, dbName string) string {
+	statsEndpoint := fmt.Sprintf(databaseStatsEndpoint, orgName, dbName)
+	return fmt.Sprintf("%s/%s", baseURL, statsEndpoint)
+}
+
+// getInstancesEndpoint returns the endpoint for the Turso API database instances service
+func getInstancesEndpoint(baseURL, orgName, dbName string) string {
+	instEndpoint := fmt.Sprintf(instancesEndpoint, orgName, dbName)
+	return fmt.Sprintf("%s/%s", baseURL, instEndpoint)
+}
+
+// getDatabaseTransferEndpoint returns the endpoint for the Turso API database transfer service
+func getDatabaseTransferEndpoint(baseURL, orgName, dbName string) string {
+	transferEndpoint := fmt.Sprintf(databaseTransferEndpoint, orgName, dbName)
+	return fmt.Sprintf("%s/%s", baseURL, transferEndpoint)
+}
+
 // CreateDatabase satisfies the databaseService interface
 func (s *DatabaseService) CreateDatabase(ctx context.Context, db CreateDatabaseRequest) (*CreateDatabaseResponse, error) {
-	// Sanitize the database name
-	if err := validateDatabaseName(db.Name); err != nil {
+	// Sanitize the database name and validate the seed options
+	if err := validateCreateDatabaseRequest(db); err != nil {
 		return nil, err
 	}
 
@@ -215,6 +386,288 @@ func (s *DatabaseService) DeleteDatabase(ctx context.Context, dbName string) (*D
 	return &out, nil
 }
 
+// GetDatabaseConfig satisfies the databaseService interface
+func (s *DatabaseService) GetDatabaseConfig(ctx context.Context, dbName string) (*DatabaseConfig, error) {
+	if err := validateDatabaseName(dbName); err != nil {
+		return nil, err
+	}
+
+	endpoint := getDatabaseConfigEndpoint(s.client.cfg.BaseURL, s.client.cfg.OrgName, dbName)
+
+	resp, err := s.client.DoRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	var out DatabaseConfig
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newBadRequestError("database configuration", "getting", resp.StatusCode)
+	}
+
+	return &out, nil
+}
+
+// UpdateDatabaseConfig satisfies the databaseService interface
+func (s *DatabaseService) UpdateDatabaseConfig(ctx context.Context, req UpdateDatabaseConfigRequest) (*DatabaseConfig, error) {
+	if err := validateDatabaseName(req.DatabaseName); err != nil {
+		return nil, err
+	}
+
+	endpoint := getDatabaseConfigEndpoint(s.client.cfg.BaseURL, s.client.cfg.OrgName, req.DatabaseName)
+
+	resp, err := s.client.DoRequest(ctx, http.MethodPatch, endpoint, req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	var out DatabaseConfig
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newBadRequestError("database configuration", "updating", resp.StatusCode)
+	}
+
+	return &out, nil
+}
+
+// GetDatabaseUsage satisfies the databaseService interface
+// the API defaults are used for from and to when they are not set
+func (s *DatabaseService) GetDatabaseUsage(ctx context.Context, dbName string, from, to time.Time) (*GetDatabaseUsageResponse, error) {
+	if err := validateDatabaseName(dbName); err != nil {
+		return nil, err
+	}
+
+	if !from.IsZero() && !to.IsZero() && to.Before(from) {
+		return nil, newInvalidFieldError("to", "must not be before from")
+	}
+
+	endpoint := getDatabaseUsageEndpoint(s.client.cfg.BaseURL, s.client.cfg.OrgName, dbName, from, to)
+
+	resp, err := s.client.DoRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	var out GetDatabaseUsageResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newBadRequestError("database usage", "getting", resp.StatusCode)
+	}
+
+	return &out, nil
+}
+
+// GetDatabaseStats satisfies the databaseService interface
+func (s *DatabaseService) GetDatabaseStats(ctx context.Context, dbName string) (*GetDatabaseStatsResponse, error) {
+	if err := validateDatabaseName(dbName); err != nil {
+		return nil, err
+	}
+
+	endpoint := getDatabaseStatsEndpoint(s.client.cfg.BaseURL, s.client.cfg.OrgName, dbName)
+
+	resp, err := s.client.DoRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	var out GetDatabaseStatsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newBadRequestError("database stats", "getting", resp.StatusCode)
+	}
+
+	return &out, nil
+}
+
+// ListInstances satisfies the databaseService interface
+func (s *DatabaseService) ListInstances(ctx context.Context, dbName string) (*ListInstancesResponse, error) {
+	if err := validateDatabaseName(dbName); err != nil {
+		return nil, err
+	}
+
+	endpoint := getInstancesEndpoint(s.client.cfg.BaseURL, s.client.cfg.OrgName, dbName)
+
+	resp, err := s.client.DoRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	var out ListInstancesResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newBadRequestError("instances", "listing", resp.StatusCode)
+	}
+
+	return &out, nil
+}
+
+// GetInstance satisfies the databaseService interface
+func (s *DatabaseService) GetInstance(ctx context.Context, dbName, instanceName string) (*GetInstanceResponse, error) {
+	if err := validateInstanceRequest(dbName, instanceName); err != nil {
+		return nil, err
+	}
+
+	endpoint := getInstancesEndpoint(s.client.cfg.BaseURL, s.client.cfg.OrgName, dbName)
+	endpoint = fmt.Sprintf("%s/%s", endpoint, instanceName)
+
+	resp, err := s.client.DoRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	var out GetInstanceResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newBadRequestError("instance", "getting", resp.StatusCode)
+	}
+
+	return &out, nil
+}
+
+// DeleteInstance satisfies the databaseService interface
+func (s *DatabaseService) DeleteInstance(ctx context.Context, dbName, instanceName string) error {
+	if err := validateInstanceRequest(dbName, instanceName); err != nil {
+		return err
+	}
+
+	endpoint := getInstancesEndpoint(s.client.cfg.BaseURL, s.client.cfg.OrgName, dbName)
+	endpoint = fmt.Sprintf("%s/%s", endpoint, instanceName)
+
+	resp, err := s.client.DoRequest(ctx, http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	// the response body is not needed, only the status code is checked
+	if resp.StatusCode != http.StatusOK {
+		return newBadRequestError("instance", "deleting", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// TransferDatabase satisfies the databaseService interface
+// the database is moved into the group with the same name in the target organization, ErrGroupMismatch is returned
+// when that group does not exist, other failures wrap ErrPermissionDenied or ErrConflict
+func (s *DatabaseService) TransferDatabase(ctx context.Context, dbName, targetOrg string) (*Database, error) {
+	if err := validateDatabaseName(dbName); err != nil {
+		return nil, err
+	}
+
+	if err := validateTransferRequest(dbName, targetOrg, s.client.cfg.OrgName); err != nil {
+		return nil, err
+	}
+
+	db, err := s.GetDatabase(ctx, dbName)
+	if err != nil {
+		return nil, err
+	}
+
+	if db.Database == nil {
+		return nil, fmt.Errorf("database %s: %w", dbName, ErrNotFound)
+	}
+
+	// databases outside of a group have no group to match in the target organization
+	if db.Database.Group != "" {
+		if err := s.checkTargetGroup(ctx, db.Database.Group, targetOrg); err != nil {
+			return nil, err
+		}
+	}
+
+	endpoint := getDatabaseTransferEndpoint(s.client.cfg.BaseURL, s.client.cfg.OrgName, dbName)
+
+	resp, err := s.client.DoRequest(ctx, http.MethodPost, endpoint, TransferDatabaseRequest{Organization: targetOrg})
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	var out Database
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newBadRequestError("database", "transferring", resp.StatusCode)
+	}
+
+	return &out, nil
+}
+
+// checkTargetGroup ensures the target organization has a group with the given name
+func (s *DatabaseService) checkTargetGroup(ctx context.Context, groupName, targetOrg string) error {
+	endpoint := getGroupEndpoint(s.client.cfg.BaseURL, targetOrg)
+	endpoint = fmt.Sprintf("%s/%s", endpoint, groupName)
+
+	resp, err := s.client.DoRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return nil
+	case http.StatusNotFound:
+		return fmt.Errorf("%w: group %s not found in organization %s", ErrGroupMismatch, groupName, targetOrg)
+	default:
+		return newBadRequestError("group", "getting", resp.StatusCode)
+	}
+}
+
+// validateInstanceRequest validates the database and instance names
+func validateInstanceRequest(dbName, instanceName string) error {
+	if err := validateDatabaseName(dbName); err != nil {
+		return err
+	}
+
+	if instanceName == "" {
+		return newMissingRequiredFieldError("instance")
+	}
+
+	return nil
+}
+
+// ValidateDatabaseName validates the database name to ensure it meets the requirements set by the Turso API,
+// it returns ErrInvalidDatabaseName when the name is invalid
+func ValidateDatabaseName(name string) error {
+	return validateDatabaseName(name)
+}
+
 // validateDatabaseName validates the database name to ensure it meets the requirements set by the Turso API
 func validateDatabaseName(name string) error {
 	match, err := regexp.MatchString(regexName, name)
@@ -0,0 +1,295 @@
+package turso
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	branchSeparator = "--"
+	// base36 unix timestamps are 6 characters until 2038 and 7 characters after
+	branchMinTimestampLength = 6
+	branchMaxTimestampLength = 7
+	// branchOverhead is the length the naming convention adds to the source and branch names
+	branchOverhead = 2*len(branchSeparator) + branchMaxTimestampLength
+)
+
+// branchEpoch is the earliest creation time accepted when parsing branch names, names with an older
+// timestamp do not follow the naming convention
+var branchEpoch = time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// DatabaseBranch is a copy of a source database created by BranchDatabase
+// branches are named <source>--<branch>--<base36 unix timestamp> so they can be listed and pruned by age
+type DatabaseBranch struct {
+	// Name is the name of the branch
+	Name string
+	// Source is the name of the database the branch was copied from
+	Source string
+	// Database is the name of the branch database
+	Database string
+	// CreatedAt is the time the branch was created
+	CreatedAt time.Time
+}
+
+// BranchDatabaseRequest is the struct to create a branch of a database
+type BranchDatabaseRequest struct {
+	// Source is the name of the database to copy
+	Source string
+	// Branch is the name of the branch, e.g. pr-1234
+	Branch string
+	// Expiration is the expiration of the branch token, defaults to never
+	Expiration string
+	// Authorization is the authorization of the branch token, defaults to full-access
+	Authorization string
+	// Wait configures how the branch is polled until it is ready
+	Wait WaitOptions
+}
+
+// BranchDatabaseResponse is the result of creating a branch of a database
+type BranchDatabaseResponse struct {
+	// Branch is the created branch
+	Branch DatabaseBranch
+	// Database is the branch database
+	Database *Database
+	// Connection is the connection info of the branch database using a token scoped to the branch
+	Connection *ConnectionInfo
+}
+
+// PruneBranchesRequest is the struct to delete the branches of a database
+type PruneBranchesRequest struct {
+	// Source is the name of the database the branches were copied from
+	Source string
+	// OlderThan only deletes branches created more than the duration ago, all branches are deleted when zero
+	OlderThan time.Duration
+	// DryRun returns the branches that would be deleted without deleting them
+	DryRun bool
+	// Bulk configures the concurrency and progress of the deletes
+	Bulk BulkOptions
+}
+
+// BranchDatabase creates a copy of the source database in the same group, waits until it is ready and mints a token
+// scoped to the copy; the branch is deleted when it cannot be made ready or the token cannot be created
+func (c *Client) BranchDatabase(ctx context.Context, req BranchDatabaseRequest) (*BranchDatabaseResponse, error) {
+	if req.Expiration == "" {
+		req.Expiration = DefaultExpiration
+	}
+
+	if req.Authorization == "" {
+		req.Authorization = FullAccess
+	}
+
+	if err := validateBranchDatabaseRequest(req); err != nil {
+		return nil, err
+	}
+
+	source, err := c.Database.GetDatabase(ctx, req.Source)
+	if err != nil {
+		return nil, err
+	}
+
+	branch := DatabaseBranch{
+		Name:      req.Branch,
+		Source:    req.Source,
+		CreatedAt: time.Unix(time.Now().Unix(), 0),
+	}
+	branch.Database = branchDatabaseName(req.Source, req.Branch, branch.CreatedAt)
+
+	_, err = c.Database.CreateDatabase(ctx, CreateDatabaseRequest{
+		Name:     branch.Database,
+		Group:    source.Database.Group,
+		IsSchema: source.Database.IsSchema,
+		Schema:   source.Database.Schema,
+		Seed:     SeedFromDatabase(req.Source),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := c.readyBranch(ctx, branch, req)
+	if err != nil {
+		// remove the branch so a failed preview environment does not linger until it is pruned
+		if _, delErr := c.Database.DeleteDatabase(context.WithoutCancel(ctx), branch.Database); delErr != nil {
+			return nil, errors.Join(err, delErr)
+		}
+
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// readyBranch waits for the branch database and creates its token
+func (c *Client) readyBranch(ctx context.Context, branch DatabaseBranch, req BranchDatabaseRequest) (*BranchDatabaseResponse, error) {
+	db, err := c.WaitForDatabase(ctx, branch.Database, req.Wait)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := c.DatabaseTokens.CreateDatabaseToken(ctx, CreateDatabaseTokenRequest{
+		DatabaseName:  branch.Database,
+		Expiration:    req.Expiration,
+		Authorization: req.Authorization,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &BranchDatabaseResponse{
+		Branch:     branch,
+		Database:   db,
+		Connection: NewConnectionInfo(db).WithToken(token.JWT),
+	}, nil
+}
+
+// ListBranches lists the branches of the source database, oldest first
+func (c *Client) ListBranches(ctx context.Context, source string) ([]DatabaseBranch, error) {
+	if err := validateDatabaseName(source); err != nil {
+		return nil, err
+	}
+
+	branches := []DatabaseBranch{}
+
+	for db, err := range c.Database.Databases(ctx, ListDatabasesRequest{Prefix: source + branchSeparator}) {
+		if err != nil {
+			return nil, err
+		}
+
+		branch, ok := parseBranchDatabaseName(source, db.Name)
+		if !ok {
+			continue
+		}
+
+		branches = append(branches, branch)
+	}
+
+	slices.SortFunc(branches, func(a, b DatabaseBranch) int {
+		if c := a.CreatedAt.Compare(b.CreatedAt); c != 0 {
+			return c
+		}
+
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return branches, nil
+}
+
+// PruneBranches deletes the branches of the source database older than the request duration
+// it returns the deleted branches, or the branches that would be deleted on a dry run, along with the failures
+// joined into a single error
+func (c *Client) PruneBranches(ctx context.Context, req PruneBranchesRequest) ([]DatabaseBranch, error) {
+	if req.OlderThan < 0 {
+		return nil, newInvalidFieldError("olderThan", "must not be negative")
+	}
+
+	branches, err := c.ListBranches(ctx, req.Source)
+	if err != nil {
+		return nil, err
+	}
+
+	cutoff := time.Now().Add(-req.OlderThan)
+
+	expired := []DatabaseBranch{}
+
+	for _, branch := range branches {
+		if branch.CreatedAt.Before(cutoff) {
+			expired = append(expired, branch)
+		}
+	}
+
+	if req.DryRun {
+		return expired, nil
+	}
+
+	names := make([]string, len(expired))
+	for i, branch := range expired {
+		names[i] = branch.Database
+	}
+
+	results, err := c.BulkDeleteDatabases(ctx, names, req.Bulk)
+
+	pruned := []DatabaseBranch{}
+
+	for _, result := range results {
+		if result.Err == nil {
+			pruned = append(pruned, expired[result.Index])
+		}
+	}
+
+	return pruned, err
+}
+
+// branchDatabaseName returns the name of the branch database following the branch naming convention
+func branchDatabaseName(source, branch string, createdAt time.Time) string {
+	return source + branchSeparator + branch + branchSeparator + strconv.FormatInt(createdAt.Unix(), 36) //nolint:mnd
+}
+
+// parseBranchDatabaseName parses a database name following the branch naming convention of the source database
+func parseBranchDatabaseName(source, name string) (DatabaseBranch, bool) {
+	rest, ok := strings.CutPrefix(name, source+branchSeparator)
+	if !ok {
+		return DatabaseBranch{}, false
+	}
+
+	branch, created, ok := strings.Cut(rest, branchSeparator)
+	if !ok || branch == "" {
+		return DatabaseBranch{}, false
+	}
+
+	// any lowercase word parses as base36, only accept timestamps the naming convention could have produced
+	if len(created) < branchMinTimestampLength || len(created) > branchMaxTimestampLength {
+		return DatabaseBranch{}, false
+	}
+
+	ts, err := strconv.ParseInt(created, 36, 64)            //nolint:mnd
+	if err != nil || strconv.FormatInt(ts, 36) != created { //nolint:mnd
+		return DatabaseBranch{}, false
+	}
+
+	createdAt := time.Unix(ts, 0)
+	if createdAt.Before(branchEpoch) || createdAt.After(time.Now().Add(24*time.Hour)) { //nolint:mnd
+		return DatabaseBranch{}, false
+	}
+
+	return DatabaseBranch{
+		Name:      branch,
+		Source:    source,
+		Database:  name,
+		CreatedAt: createdAt,
+	}, true
+}
+
+// validateBranchDatabaseRequest validates the branch database request
+func validateBranchDatabaseRequest(req BranchDatabaseRequest) error {
+	if err := validateDatabaseName(req.Source); err != nil {
+		return err
+	}
+
+	if req.Branch == "" {
+		return newMissingRequiredFieldError("branch")
+	}
+
+	if match, _ := regexp.MatchString(regexName, req.Branch); !match {
+		return newInvalidFieldError("branch", "must only contain lowercase letters, numbers and dashes")
+	}
+
+	if strings.Contains(req.Branch, branchSeparator) {
+		return newInvalidFieldError("branch", "must not contain "+branchSeparator)
+	}
+
+	maxBranch := maxNameLength - branchOverhead - len(req.Source)
+	if maxBranch <= 0 {
+		return newInvalidFieldError("source", fmt.Sprintf("must be at most %d characters to be branched", maxNameLength-branchOverhead-1))
+	}
+
+	if len(req.Branch) > maxBranch {
+		return newInvalidFieldError("branch", fmt.Sprintf("must be at most %d characters for source %s", maxBranch, req.Source))
+	}
+
+	return validateTokenOptions(req.Expiration, req.Authorization)
+}
@@ -0,0 +1,206 @@
+package turso
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBranchDatabase(t *testing.T) {
+	client, databases := newMemoryClient(&Database{Name: "template", Group: "previews", Schema: "parent-db"})
+
+	resp, err := client.BranchDatabase(context.Background(), BranchDatabaseRequest{Source: "template", Branch: "pr-1234"})
+	require.NoError(t, err)
+	assert.Equal(t, "pr-1234", resp.Branch.Name)
+	assert.Equal(t, "template", resp.Branch.Source)
+	assert.WithinDuration(t, time.Now(), resp.Branch.CreatedAt, time.Minute)
+	assert.Equal(t, branchDatabaseName("template", "pr-1234", resp.Branch.CreatedAt), resp.Branch.Database)
+	assert.Equal(t, resp.Branch.Database, resp.Database.Name)
+	assert.Equal(t, "libsql://"+resp.Branch.Database+"-meow.turso.io", resp.Connection.LibsqlURL())
+	assert.Equal(t, "jwt-token", resp.Connection.Token)
+
+	require.Len(t, databases.created, 1)
+	assert.Equal(t, CreateDatabaseRequest{
+		Name:   resp.Branch.Database,
+		Group:  "previews",
+		Schema: "parent-db",
+		Seed:   SeedFromDatabase("template"),
+	}, databases.created[0])
+
+	branches, err := client.ListBranches(context.Background(), "template")
+	require.NoError(t, err)
+	assert.Equal(t, []DatabaseBranch{resp.Branch}, branches)
+
+	// missing source
+	_, err = client.BranchDatabase(context.Background(), BranchDatabaseRequest{Source: "missing", Branch: "pr-1234"})
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestBranchDatabaseTokenError(t *testing.T) {
+	client, databases := newMemoryClient(&Database{Name: "template", Group: "previews"})
+
+	tokenErr := errors.New("token error")
+	client.DatabaseTokens.(*MockDatabaseTokensService).Error = tokenErr
+
+	resp, err := client.BranchDatabase(context.Background(), BranchDatabaseRequest{Source: "template", Branch: "pr-1234"})
+	require.ErrorIs(t, err, tokenErr)
+	assert.Nil(t, resp)
+
+	// the branch is removed
+	require.Len(t, databases.deleted, 1)
+	assert.Len(t, databases.databases, 1)
+}
+
+func TestPruneBranches(t *testing.T) {
+	now := time.Now()
+	old := branchDatabaseName("template", "pr-1", now.Add(-48*time.Hour))
+	older := branchDatabaseName("template", "pr-2", now.Add(-72*time.Hour))
+	recent := branchDatabaseName("template", "pr-3", now.Add(-time.Hour))
+
+	client, databases := newMemoryClient(
+		&Database{Name: "template"},
+		&Database{Name: "template-other"},
+		&Database{Name: "template--not-a-branch"},
+		&Database{Name: "template--staging--eu"},
+		&Database{Name: old},
+		&Database{Name: older},
+		&Database{Name: recent},
+	)
+
+	branches, err := client.ListBranches(context.Background(), "template")
+	require.NoError(t, err)
+	require.Len(t, branches, 3)
+	assert.Equal(t, []string{"pr-2", "pr-1", "pr-3"}, []string{branches[0].Name, branches[1].Name, branches[2].Name})
+
+	// dry run
+	pruned, err := client.PruneBranches(context.Background(), PruneBranchesRequest{Source: "template", OlderThan: 24 * time.Hour, DryRun: true})
+	require.NoError(t, err)
+	assert.Len(t, pruned, 2)
+	assert.Empty(t, databases.deleted)
+
+	// partial failure
+	boom := errors.New("boom")
+	databases.fail["delete "+old] = boom
+
+	pruned, err = client.PruneBranches(context.Background(), PruneBranchesRequest{Source: "template", OlderThan: 24 * time.Hour})
+	require.ErrorIs(t, err, boom)
+	require.Len(t, pruned, 1)
+	assert.Equal(t, older, pruned[0].Database)
+
+	// re-run
+	delete(databases.fail, "delete "+old)
+
+	pruned, err = client.PruneBranches(context.Background(), PruneBranchesRequest{Source: "template", OlderThan: 24 * time.Hour})
+	require.NoError(t, err)
+	require.Len(t, pruned, 1)
+	assert.Equal(t, old, pruned[0].Database)
+	assert.Contains(t, databases.databases, recent)
+	assert.Contains(t, databases.databases, "template--not-a-branch")
+
+	// pruning every branch never deletes databases outside the naming convention
+	pruned, err = client.PruneBranches(context.Background(), PruneBranchesRequest{Source: "template"})
+	require.NoError(t, err)
+	require.Len(t, pruned, 1)
+	assert.Equal(t, recent, pruned[0].Database)
+	assert.Contains(t, databases.databases, "template--staging--eu")
+	assert.Contains(t, databases.databases, "template--not-a-branch")
+	assert.Contains(t, databases.databases, "template-other")
+}
+
+func TestParseBranchDatabaseName(t *testing.T) {
+	createdAt := time.Unix(1704067200, 0)
+
+	branch, ok := parseBranchDatabaseName("template", branchDatabaseName("template", "feature-x", createdAt))
+	require.True(t, ok)
+	assert.Equal(t, "feature-x", branch.Name)
+	assert.True(t, createdAt.Equal(branch.CreatedAt))
+
+	_, ok = parseBranchDatabaseName("template", "template--pr-1")
+	assert.False(t, ok)
+
+	_, ok = parseBranchDatabaseName("template", "template--pr-1--not-a-time")
+	assert.False(t, ok)
+
+	_, ok = parseBranchDatabaseName("template", "other--pr-1--s6k2o0")
+	assert.False(t, ok)
+
+	// words that parse as base36 but are not timestamps of the naming convention
+	for _, name := range []string{
+		"template--staging--eu",
+		"template--staging--backup",
+		"template--pr-1--zzzzzzz",
+		"template--pr-1--0s6k2o0",
+		"template--pr-1--" + strconv.FormatInt(time.Date(2019, time.December, 31, 0, 0, 0, 0, time.UTC).Unix(), 36),
+	} {
+		_, ok = parseBranchDatabaseName("template", name)
+		assert.False(t, ok, name)
+	}
+}
+
+func TestValidateBranchDatabaseRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		request BranchDatabaseRequest
+		wantErr error
+	}{
+		{
+			name:    "valid request",
+			request: BranchDatabaseRequest{Source: "template", Branch: "pr-1234", Expiration: "7d", Authorization: ReadOnly},
+			wantErr: nil,
+		},
+		{
+			name:    "invalid source",
+			request: BranchDatabaseRequest{Source: "Template", Branch: "pr-1234", Expiration: "7d", Authorization: ReadOnly},
+			wantErr: ErrInvalidDatabaseName,
+		},
+		{
+			name:    "missing branch",
+			request: BranchDatabaseRequest{Source: "template", Expiration: "7d", Authorization: ReadOnly},
+			wantErr: &MissingRequiredFieldError{RequiredField: "branch"},
+		},
+		{
+			name:    "invalid branch",
+			request: BranchDatabaseRequest{Source: "template", Branch: "PR_1234", Expiration: "7d", Authorization: ReadOnly},
+			wantErr: &InvalidFieldError{Field: "branch", Message: "must only contain lowercase letters, numbers and dashes"},
+		},
+		{
+			name:    "branch too long",
+			request: BranchDatabaseRequest{Source: "template", Branch: "feature-with-a-long-name", Expiration: "7d", Authorization: ReadOnly},
+			wantErr: &InvalidFieldError{Field: "branch", Message: "must be at most 13 characters for source template"},
+		},
+		{
+			name:    "source too long",
+			request: BranchDatabaseRequest{Source: "a-very-long-template-name", Branch: "pr-1", Expiration: "7d", Authorization: ReadOnly},
+			wantErr: &InvalidFieldError{Field: "source", Message: "must be at most 20 characters to be branched"},
+		},
+		{
+			name:    "branch with separator",
+			request: BranchDatabaseRequest{Source: "template", Branch: "pr--1234", Expiration: "7d", Authorization: ReadOnly},
+			wantErr: &InvalidFieldError{Field: "branch", Message: "must not contain --"},
+		},
+		{
+			name:    "invalid expiration",
+			request: BranchDatabaseRequest{Source: "template", Branch: "pr-1234", Expiration: "soon", Authorization: ReadOnly},
+			wantErr: ErrExpirationInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateBranchDatabaseRequest(tt.request)
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.wantErr.Error())
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package turso
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+const defaultBulkWorkers = 4
+
+// BulkOptions configures a bulk database operation
+type BulkOptions struct {
+	// Workers is the number of operations run concurrently, defaults to 4
+	Workers int
+	// Progress is called after every operation with the number of completed operations, the total and the result
+	// calls are never made concurrently
+	Progress func(done, total int, result BulkResult)
+}
+
+// BulkResult is the result of a single operation in a bulk database operation
+type BulkResult struct {
+	// Index is the position of the item in the request
+	Index int
+	// Name is the name of the database
+	Name string
+	// Database is the created database, only set for successful creates
+	Database *CreateDatabase
+	// Err is set when the operation failed
+	Err error
+}
+
+// BulkCreateDatabases creates the databases concurrently, continuing past individual failures
+// the results are returned in the order of the requests along with the failures joined into a single error
+func (c *Client) BulkCreateDatabases(ctx context.Context, reqs []CreateDatabaseRequest, opts BulkOptions) ([]BulkResult, error) {
+	names := make([]string, len(reqs))
+	for i, req := range reqs {
+		names[i] = req.Name
+	}
+
+	return runBulk(ctx, names, opts, func(ctx context.Context, i int) BulkResult {
+		result := BulkResult{Index: i, Name: reqs[i].Name}
+
+		out, err := c.Database.CreateDatabase(ctx, reqs[i])
+		if err != nil {
+			result.Err = err
+
+			return result
+		}
+
+		result.Database = &out.Database
+
+		return result
+	})
+}
+
+// BulkDeleteDatabases deletes the databases concurrently, continuing past individual failures
+// the results are returned in the order of the names along with the failures joined into a single error
+func (c *Client) BulkDeleteDatabases(ctx context.Context, names []string, opts BulkOptions) ([]BulkResult, error) {
+	return runBulk(ctx, names, opts, func(ctx context.Context, i int) BulkResult {
+		_, err := c.Database.DeleteDatabase(ctx, names[i])
+
+		return BulkResult{Index: i, Name: names[i], Err: err}
+	})
+}
+
+// runBulk runs the operation for every item using a fixed number of workers
+// items that were not started before the context is done fail with the context error
+func runBulk(ctx context.Context, names []string, opts BulkOptions, op func(ctx context.Context, i int) BulkResult) ([]BulkResult, error) {
+	total := len(names)
+
+	workers := opts.Workers
+	if workers < 1 {
+		workers = defaultBulkWorkers
+	}
+
+	results := make([]BulkResult, total)
+	items := make(chan int)
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		done int
+	)
+
+	for range min(workers, total) {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := range items {
+				result := op(ctx, i)
+				results[i] = result
+
+				if opts.Progress != nil {
+					mu.Lock()
+					done++
+					opts.Progress(done, total, result)
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+
+	next := 0
+
+feed:
+	for ; next < total; next++ {
+		select {
+		case items <- next:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+
+	close(items)
+	wg.Wait()
+
+	for i := next; i < total; i++ {
+		results[i] = BulkResult{Index: i, Name: names[i], Err: ctx.Err()}
+	}
+
+	var errs []error
+
+	for _, result := range results {
+		if result.Err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", result.Name, result.Err))
+		}
+	}
+
+	return results, errors.Join(errs...)
+}
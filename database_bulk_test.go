@@ -0,0 +1,130 @@
+package turso
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBulkCreateDatabases(t *testing.T) {
+	client, databases := newMemoryClient()
+
+	boom := errors.New("boom")
+	databases.fail["create tenant-3"] = boom
+
+	reqs := []CreateDatabaseRequest{}
+	for i := range 10 {
+		reqs = append(reqs, CreateDatabaseRequest{Name: fmt.Sprintf("tenant-%d", i), Group: "tenants"})
+	}
+
+	var progress []int
+
+	results, err := client.BulkCreateDatabases(context.Background(), reqs, BulkOptions{
+		Workers: 3,
+		Progress: func(done, total int, result BulkResult) {
+			assert.Equal(t, 10, total)
+
+			progress = append(progress, done)
+		},
+	})
+	require.Error(t, err)
+	assert.ErrorIs(t, err, boom)
+	assert.ErrorContains(t, err, "tenant-3: boom")
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, progress)
+
+	require.Len(t, results, 10)
+
+	for i, result := range results {
+		assert.Equal(t, i, result.Index)
+		assert.Equal(t, reqs[i].Name, result.Name)
+
+		if i == 3 {
+			require.ErrorIs(t, result.Err, boom)
+			assert.Nil(t, result.Database)
+
+			continue
+		}
+
+		require.NoError(t, result.Err)
+		assert.Equal(t, reqs[i].Name, result.Database.Name)
+	}
+
+	assert.Len(t, databases.databases, 9)
+}
+
+func TestBulkDeleteDatabases(t *testing.T) {
+	client, databases := newMemoryClient(
+		&Database{Name: "tenant-1"},
+		&Database{Name: "tenant-2"},
+	)
+
+	results, err := client.BulkDeleteDatabases(context.Background(), []string{"tenant-1", "tenant-2", "tenant-3"}, BulkOptions{})
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrNotFound)
+	require.Len(t, results, 3)
+	require.NoError(t, results[0].Err)
+	require.NoError(t, results[1].Err)
+	require.ErrorIs(t, results[2].Err, ErrNotFound)
+	assert.Empty(t, databases.databases)
+
+	// cancelled context fails all remaining items
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results, err = client.BulkDeleteDatabases(ctx, []string{"tenant-1", "tenant-2"}, BulkOptions{Workers: 1})
+	require.ErrorIs(t, err, context.Canceled)
+	require.Len(t, results, 2)
+
+	for _, result := range results {
+		assert.Error(t, result.Err)
+	}
+}
+
+type countingLimiter struct {
+	calls atomic.Int32
+	err   error
+}
+
+func (l *countingLimiter) Wait(ctx context.Context) error {
+	l.calls.Add(1)
+
+	return l.err
+}
+
+func TestBulkDeleteDatabasesRateLimited(t *testing.T) {
+	client, err := NewClient(Config{
+		Token:   "api-token",
+		BaseURL: "http://localhost",
+		OrgName: "meow",
+	})
+	require.NoError(t, err)
+
+	client.client = MockHTTPRequestDoerFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(bytes.NewReader([]byte(`{"database":"my-db"}`))),
+		}, nil
+	})
+
+	limiter := &countingLimiter{}
+	client.SetRateLimiter(limiter)
+
+	_, err = client.BulkDeleteDatabases(context.Background(), []string{"tenant-1", "tenant-2", "tenant-3"}, BulkOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), limiter.calls.Load())
+
+	// limiter errors fail the request
+	limiter.err = errors.New("rate: wait would exceed context deadline")
+
+	results, err := client.BulkDeleteDatabases(context.Background(), []string{"tenant-1"}, BulkOptions{})
+	require.ErrorIs(t, err, limiter.err)
+	require.ErrorIs(t, results[0].Err, limiter.err)
+}
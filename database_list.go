@@ -0,0 +1,157 @@
+package turso
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// ListDatabasesRequest is the struct to filter the databases in the organization
+// Group and Schema are filtered by the API, Type and Prefix are filtered by the client
+type ListDatabasesRequest struct {
+	// Group only returns databases in the group
+	Group string
+	// Schema only returns databases using the parent schema database
+	Schema string
+	// Type only returns databases of the type
+	Type string
+	// Prefix only returns databases with a name starting with the prefix
+	Prefix string
+}
+
+// matches returns true when the database matches all filters of the request
+func (r ListDatabasesRequest) matches(db *Database) bool {
+	if r.Group != "" && db.Group != r.Group {
+		return false
+	}
+
+	if r.Schema != "" && db.Schema != r.Schema {
+		return false
+	}
+
+	if r.Type != "" && db.Type != r.Type {
+		return false
+	}
+
+	return strings.HasPrefix(db.Name, r.Prefix)
+}
+
+// getListDatabasesEndpoint returns the endpoint to list databases with the filters supported by the API
+func getListDatabasesEndpoint(baseURL, orgName string, req ListDatabasesRequest) string {
+	endpoint := getDatabaseEndpoint(baseURL, orgName)
+
+	params := url.Values{}
+
+	if req.Group != "" {
+		params.Set("group", req.Group)
+	}
+
+	if req.Schema != "" {
+		params.Set("schema", req.Schema)
+	}
+
+	if len(params) == 0 {
+		return endpoint
+	}
+
+	return fmt.Sprintf("%s?%s", endpoint, params.Encode())
+}
+
+// Databases satisfies the databaseService interface
+// the API does not paginate databases, so the response is decoded one database at a time
+// to avoid holding every database in memory
+func (s *DatabaseService) Databases(ctx context.Context, req ListDatabasesRequest) iter.Seq2[*Database, error] {
+	return func(yield func(*Database, error) bool) {
+		endpoint := getListDatabasesEndpoint(s.client.cfg.BaseURL, s.client.cfg.OrgName, req)
+
+		resp, err := s.client.DoRequest(ctx, http.MethodGet, endpoint, nil)
+		if err != nil {
+			yield(nil, err)
+
+			return
+		}
+
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			yield(nil, newBadRequestError("databases", "listing", resp.StatusCode))
+
+			return
+		}
+
+		dec := json.NewDecoder(resp.Body)
+
+		found, err := seekArray(dec, "databases")
+		if err != nil {
+			yield(nil, err)
+
+			return
+		}
+
+		if !found {
+			return
+		}
+
+		for dec.More() {
+			var db Database
+			if err := dec.Decode(&db); err != nil {
+				yield(nil, err)
+
+				return
+			}
+
+			if !req.matches(&db) {
+				continue
+			}
+
+			if !yield(&db, nil) {
+				return
+			}
+		}
+	}
+}
+
+// seekArray advances the decoder to the first element of the array with the given key in the top level object
+// false is returned when the key is not present or the value is null
+func seekArray(dec *json.Decoder, key string) (bool, error) {
+	if _, err := dec.Token(); err != nil {
+		return false, err
+	}
+
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return false, err
+		}
+
+		if tok != key {
+			var skip json.RawMessage
+			if err := dec.Decode(&skip); err != nil {
+				return false, err
+			}
+
+			continue
+		}
+
+		tok, err = dec.Token()
+		if err != nil {
+			return false, err
+		}
+
+		if tok == nil {
+			return false, nil
+		}
+
+		if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+			return false, newInvalidFieldError(key, "must be an array")
+		}
+
+		return true, nil
+	}
+
+	return false, nil
+}
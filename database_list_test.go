@@ -0,0 +1,123 @@
+package turso
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newListDatabasesClient(t *testing.T, status int, body string, query string) *Client {
+	return &Client{
+		cfg: &Config{
+			BaseURL: "http://localhost",
+			OrgName: "meow",
+		},
+		client: MockHTTPRequestDoerFunc(func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Equal(t, "/v1/organizations/meow/databases", req.URL.Path)
+			assert.Equal(t, query, req.URL.RawQuery)
+
+			return &http.Response{
+				StatusCode: status,
+				Body:       io.NopCloser(bytes.NewReader([]byte(body))),
+			}, nil
+		}),
+	}
+}
+
+func TestDatabases(t *testing.T) {
+	body := `{"other":{"nested":[1,2]},"databases":[
+		{"Name":"tenant-1","group":"tenants","type":"logical","schema":"tenant-schema"},
+		{"Name":"admin","group":"tenants","type":"logical","schema":"tenant-schema"},
+		{"Name":"tenant-2","group":"tenants","type":"schema","schema":"tenant-schema"},
+		{"Name":"tenant-3","group":"tenants","type":"logical","schema":"tenant-schema"}
+	]}`
+
+	databaseService := DatabaseService{client: newListDatabasesClient(t, http.StatusOK, body, "group=tenants&schema=tenant-schema")}
+
+	var names []string
+
+	for db, err := range databaseService.Databases(context.Background(), ListDatabasesRequest{
+		Group:  "tenants",
+		Schema: "tenant-schema",
+		Type:   "logical",
+		Prefix: "tenant-",
+	}) {
+		require.NoError(t, err)
+
+		names = append(names, db.Name)
+	}
+
+	assert.Equal(t, []string{"tenant-1", "tenant-3"}, names)
+
+	// stop early
+	databaseService = DatabaseService{client: newListDatabasesClient(t, http.StatusOK, body, "")}
+
+	names = nil
+
+	for db, err := range databaseService.Databases(context.Background(), ListDatabasesRequest{}) {
+		require.NoError(t, err)
+
+		names = append(names, db.Name)
+
+		break
+	}
+
+	assert.Equal(t, []string{"tenant-1"}, names)
+}
+
+func TestDatabasesEmpty(t *testing.T) {
+	for _, body := range []string{`{"databases":[]}`, `{"databases":null}`, `{}`} {
+		databaseService := DatabaseService{client: newListDatabasesClient(t, http.StatusOK, body, "")}
+
+		for _, err := range databaseService.Databases(context.Background(), ListDatabasesRequest{}) {
+			t.Fatalf("unexpected result for %s: %v", body, err)
+		}
+	}
+}
+
+func TestDatabasesError(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+	}{
+		{
+			name:   "bad status",
+			status: http.StatusForbidden,
+			body:   `{"error":"forbidden"}`,
+		},
+		{
+			name:   "invalid json",
+			status: http.StatusOK,
+			body:   `{"databases":[{"Name":`,
+		},
+		{
+			name:   "not an array",
+			status: http.StatusOK,
+			body:   `{"databases":"meow"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			databaseService := DatabaseService{client: newListDatabasesClient(t, tt.status, tt.body, "")}
+
+			count := 0
+
+			for db, err := range databaseService.Databases(context.Background(), ListDatabasesRequest{}) {
+				require.Error(t, err)
+				assert.Nil(t, db)
+
+				count++
+			}
+
+			assert.Equal(t, 1, count)
+		})
+	}
+}
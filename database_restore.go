@@ -0,0 +1,356 @@
+package turso
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base32"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	restoreCopySuffix     = "-r"
+	restoreCopyHashLength = 10
+	restoreCopyMaxBase    = maxNameLength - len(restoreCopySuffix) - restoreCopyHashLength
+)
+
+// restoreHashEncoding encodes the copy name hash with lowercase letters and digits only
+var restoreHashEncoding = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+
+// RestoreStepName is the name of a step in the restore workflow
+type RestoreStepName string
+
+const (
+	// RestoreStepCreateCopy creates a copy of the database at the restore point
+	RestoreStepCreateCopy RestoreStepName = "create-copy"
+	// RestoreStepVerifyCopy waits for the copy to be ready
+	RestoreStepVerifyCopy RestoreStepName = "verify-copy"
+	// RestoreStepDeleteOriginal deletes the original database
+	RestoreStepDeleteOriginal RestoreStepName = "delete-original"
+	// RestoreStepRecreateOriginal recreates the original database from the copy
+	RestoreStepRecreateOriginal RestoreStepName = "recreate-original"
+	// RestoreStepVerifyOriginal waits for the recreated database to be ready
+	RestoreStepVerifyOriginal RestoreStepName = "verify-original"
+	// RestoreStepDeleteCopy deletes the copy once it has been swapped in
+	RestoreStepDeleteCopy RestoreStepName = "delete-copy"
+)
+
+// RestoreStepStatus is the status of a step in the restore workflow
+type RestoreStepStatus string
+
+const (
+	RestoreStepStatusPlanned RestoreStepStatus = "planned"
+	RestoreStepStatusDone    RestoreStepStatus = "done"
+	RestoreStepStatusSkipped RestoreStepStatus = "skipped"
+	RestoreStepStatusFailed  RestoreStepStatus = "failed"
+)
+
+// RestoreDatabaseRequest is the struct to restore a database to a point in time
+type RestoreDatabaseRequest struct {
+	// Name is the name of the database to restore
+	Name string
+	// At is the point in time to restore the database to
+	At time.Time
+	// Swap replaces the original database with the restored copy, the copy is kept as is when false
+	Swap bool
+	// DryRun reports the steps that would be taken without making any changes
+	DryRun bool
+	// Wait configures how the copy and the recreated database are polled until they are ready
+	Wait WaitOptions
+	// Verify are the conditions the copy, and the recreated database when swapping, must meet before the
+	// workflow continues; the original is only deleted once the copy meets them
+	// defaults to DatabaseNotSleeping and DatabaseHostnameResolves using net.DefaultResolver
+	Verify []DatabaseCondition
+	// State is the progress saved by Checkpoint during a previous run, set it to resume a restore that did not finish
+	State *RestoreState
+	// Checkpoint is called after every completed step with the progress to save, a restore that swaps an existing
+	// copy can only be resumed with the saved state; the workflow stops when it returns an error
+	Checkpoint func(ctx context.Context, state RestoreState) error
+}
+
+// RestoreState is the progress of a restore, it is saved by the caller through the Checkpoint callback
+// and passed back in the request to resume
+type RestoreState struct {
+	// CopyName is the name of the restored copy of the database
+	CopyName string `json:"copy_name"`
+	// OriginalID is the ID of the original database when the restore started, a database with the same name
+	// and a different ID was recreated by the workflow and is never deleted
+	OriginalID string `json:"original_id,omitempty"`
+	// Completed are the steps that completed
+	Completed []RestoreStepName `json:"completed,omitempty"`
+}
+
+// RestoreStep is a single step of the restore workflow
+type RestoreStep struct {
+	// Name is the name of the step
+	Name RestoreStepName
+	// Status is the status of the step
+	Status RestoreStepStatus
+	// Detail describes why the step was skipped
+	Detail string
+	// Err is set when the step failed
+	Err error
+}
+
+// RestoreDatabaseResponse is the result of restoring a database
+type RestoreDatabaseResponse struct {
+	// CopyName is the name of the restored copy of the database
+	CopyName string
+	// Steps are the steps of the workflow in order
+	Steps []RestoreStep
+	// Database is the restored database, the copy or the recreated original when swapped, nil on a dry run
+	Database *Database
+	// State is the progress of the restore, it can be passed back in the request to resume the restore
+	State RestoreState
+}
+
+// RestoreDatabase restores a database to a point in time by creating a copy seeded from the database at that time,
+// when swap is requested the original database is deleted and recreated from the copy before the copy is deleted
+//
+// progress is reported through the Checkpoint callback after every step, passing the saved state back in resumes the
+// restore where it stopped; the original is identified by its ID so a recreated database is never deleted again
+// without a saved state a swap is refused with ErrRestoreInProgress when the copy already exists, as the workflow
+// cannot tell whether the original was already replaced
+func (c *Client) RestoreDatabase(ctx context.Context, req RestoreDatabaseRequest) (*RestoreDatabaseResponse, error) {
+	if err := validateRestoreDatabaseRequest(req); err != nil {
+		return nil, err
+	}
+
+	copyName := restoreCopyName(req.Name, req.At)
+
+	if req.State != nil && req.State.CopyName != copyName {
+		return nil, newInvalidFieldError("state", "does not belong to this database and restore point")
+	}
+
+	original, err := c.lookupDatabase(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	restored, err := c.lookupDatabase(ctx, copyName)
+	if err != nil {
+		return nil, err
+	}
+
+	if original == nil && restored == nil {
+		return nil, fmt.Errorf("database %s: %w", req.Name, ErrNotFound)
+	}
+
+	// a finished swap has nothing left to resume
+	if req.State != nil && req.Swap && slices.Contains(req.State.Completed, RestoreStepDeleteCopy) {
+		return &RestoreDatabaseResponse{CopyName: copyName, Database: original, State: *req.State}, nil
+	}
+
+	if req.State == nil && req.Swap && restored != nil {
+		return nil, fmt.Errorf("copy %s of database %s: %w", copyName, req.Name, ErrRestoreInProgress)
+	}
+
+	state := RestoreState{CopyName: copyName}
+	if req.State != nil {
+		state = *req.State
+		state.Completed = slices.Clone(state.Completed)
+	} else if original != nil {
+		state.OriginalID = original.DatabaseID
+	}
+
+	// the original was replaced by the workflow when it exists with a different ID than when the restore started
+	recreated := original != nil && state.OriginalID != "" && original.DatabaseID != state.OriginalID
+
+	// the copy is created with the settings of the original so either can be used to recreate the other
+	source := original
+	if source == nil {
+		source = restored
+	}
+
+	verify := req.Verify
+	if len(verify) == 0 {
+		verify = []DatabaseCondition{DatabaseNotSleeping(), DatabaseHostnameResolves(nil)}
+	}
+
+	run := &restoreRun{
+		ctx:        ctx,
+		dryRun:     req.DryRun,
+		checkpoint: req.Checkpoint,
+		out:        &RestoreDatabaseResponse{CopyName: copyName, State: state},
+	}
+
+	err = run.step(RestoreStepCreateCopy, restored != nil, "copy already exists", func() error {
+		_, err := c.Database.CreateDatabase(ctx, CreateDatabaseRequest{
+			Name:     copyName,
+			Group:    source.Group,
+			IsSchema: source.IsSchema,
+			Schema:   source.Schema,
+			Seed:     SeedFromDatabaseAt(req.Name, req.At),
+		})
+
+		return err
+	})
+	if err != nil {
+		return run.out, err
+	}
+
+	err = run.step(RestoreStepVerifyCopy, false, "", func() error {
+		restored, err = c.WaitForDatabase(ctx, copyName, req.Wait, verify...)
+
+		return err
+	})
+	if err != nil {
+		return run.out, err
+	}
+
+	if !req.Swap {
+		if !req.DryRun {
+			run.out.Database = restored
+		}
+
+		return run.out, nil
+	}
+
+	err = run.step(RestoreStepDeleteOriginal, original == nil || recreated, "original already deleted", func() error {
+		_, err := c.Database.DeleteDatabase(ctx, req.Name)
+
+		return err
+	})
+	if err != nil {
+		return run.out, err
+	}
+
+	err = run.step(RestoreStepRecreateOriginal, recreated, "original already recreated", func() error {
+		_, err := c.Database.CreateDatabase(ctx, CreateDatabaseRequest{
+			Name:     req.Name,
+			Group:    source.Group,
+			IsSchema: source.IsSchema,
+			Schema:   source.Schema,
+			Seed:     SeedFromDatabase(copyName),
+		})
+
+		return err
+	})
+	if err != nil {
+		return run.out, err
+	}
+
+	err = run.step(RestoreStepVerifyOriginal, false, "", func() error {
+		run.out.Database, err = c.WaitForDatabase(ctx, req.Name, req.Wait, verify...)
+
+		return err
+	})
+	if err != nil {
+		return run.out, err
+	}
+
+	err = run.step(RestoreStepDeleteCopy, false, "", func() error {
+		_, err := c.Database.DeleteDatabase(ctx, copyName)
+
+		return err
+	})
+
+	return run.out, err
+}
+
+// restoreRun records the steps of a restore workflow
+type restoreRun struct {
+	ctx        context.Context
+	dryRun     bool
+	checkpoint func(ctx context.Context, state RestoreState) error
+	out        *RestoreDatabaseResponse
+}
+
+// isVerifyStep returns true for steps that only wait on a database, they are run again when resuming
+func isVerifyStep(name RestoreStepName) bool {
+	return name == RestoreStepVerifyCopy || name == RestoreStepVerifyOriginal
+}
+
+// step runs the step unless it is skipped, already completed or a dry run and records the outcome
+// completed steps are checkpointed so a resumed run skips them
+func (r *restoreRun) step(name RestoreStepName, skip bool, detail string, fn func() error) error {
+	step := RestoreStep{Name: name}
+
+	switch {
+	case slices.Contains(r.out.State.Completed, name) && !isVerifyStep(name):
+		step.Status = RestoreStepStatusSkipped
+		step.Detail = "already completed"
+	case skip:
+		step.Status = RestoreStepStatusSkipped
+		step.Detail = detail
+	case r.dryRun:
+		step.Status = RestoreStepStatusPlanned
+	default:
+		if err := fn(); err != nil {
+			step.Status = RestoreStepStatusFailed
+			step.Err = err
+			r.out.Steps = append(r.out.Steps, step)
+
+			return err
+		}
+
+		step.Status = RestoreStepStatusDone
+	}
+
+	r.out.Steps = append(r.out.Steps, step)
+
+	if r.dryRun || slices.Contains(r.out.State.Completed, name) {
+		return nil
+	}
+
+	r.out.State.Completed = append(r.out.State.Completed, name)
+
+	if r.checkpoint == nil {
+		return nil
+	}
+
+	if err := r.checkpoint(r.ctx, r.out.State); err != nil {
+		return fmt.Errorf("checkpoint after %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// lookupDatabase returns the database or nil when it does not exist
+func (c *Client) lookupDatabase(ctx context.Context, dbName string) (*Database, error) {
+	out, err := c.Database.GetDatabase(ctx, dbName)
+	if errors.Is(err, ErrNotFound) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return out.Database, nil
+}
+
+// restoreCopyName returns the name of the restored copy of the database, it is always a valid database name
+// the name starts with the database name for readability but is made unique per database and restore point by
+// a hash of the full database name and the restore point, so databases sharing a prefix never share a copy
+func restoreCopyName(name string, at time.Time) string {
+	sum := sha256.Sum256([]byte(name + "@" + strconv.FormatInt(at.Unix(), 10))) //nolint:mnd
+
+	base := name
+	if len(base) > restoreCopyMaxBase {
+		base = strings.TrimRight(base[:restoreCopyMaxBase], "-")
+	}
+
+	return base + restoreCopySuffix + restoreHashEncoding.EncodeToString(sum[:])[:restoreCopyHashLength]
+}
+
+// validateRestoreDatabaseRequest validates the restore database request
+func validateRestoreDatabaseRequest(req RestoreDatabaseRequest) error {
+	if err := validateDatabaseName(req.Name); err != nil {
+		return err
+	}
+
+	if req.At.IsZero() {
+		return newMissingRequiredFieldError("at")
+	}
+
+	if req.At.After(time.Now()) {
+		return newInvalidFieldError("at", "must not be in the future")
+	}
+
+	return nil
+}
@@ -0,0 +1,458 @@
+package turso
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryDatabaseService keeps databases in memory and records the create and delete calls
+type memoryDatabaseService struct {
+	*MockDatabaseService
+	mu        sync.Mutex
+	databases map[string]*Database
+	created   []CreateDatabaseRequest
+	deleted   []string
+	fail      map[string]error
+}
+
+func newMemoryDatabaseService(databases ...*Database) *memoryDatabaseService {
+	s := &memoryDatabaseService{
+		MockDatabaseService: newMockDatabaseService().(*MockDatabaseService),
+		databases:           map[string]*Database{},
+		fail:                map[string]error{},
+	}
+
+	for _, db := range databases {
+		s.databases[db.Name] = db
+	}
+
+	return s
+}
+
+func (s *memoryDatabaseService) ListDatabases(ctx context.Context) (*ListDatabaseResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	out := &ListDatabaseResponse{}
+
+	for _, name := range slices.Sorted(maps.Keys(s.databases)) {
+		copied := *s.databases[name]
+		out.Databases = append(out.Databases, &copied)
+	}
+
+	return out, nil
+}
+
+func (s *memoryDatabaseService) Databases(ctx context.Context, req ListDatabasesRequest) iter.Seq2[*Database, error] {
+	return func(yield func(*Database, error) bool) {
+		out, _ := s.ListDatabases(ctx)
+
+		for _, db := range out.Databases {
+			if req.matches(db) && !yield(db, nil) {
+				return
+			}
+		}
+	}
+}
+
+func (s *memoryDatabaseService) GetDatabase(ctx context.Context, dbName string) (*GetDatabaseResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	db, ok := s.databases[dbName]
+	if !ok {
+		return nil, newBadRequestError("database", "getting", http.StatusNotFound)
+	}
+
+	copied := *db
+
+	return &GetDatabaseResponse{Database: &copied}, nil
+}
+
+func (s *memoryDatabaseService) CreateDatabase(ctx context.Context, req CreateDatabaseRequest) (*CreateDatabaseResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.fail["create "+req.Name]; err != nil {
+		return nil, err
+	}
+
+	if _, ok := s.databases[req.Name]; ok {
+		return nil, newBadRequestError("database", "creating", http.StatusConflict)
+	}
+
+	s.created = append(s.created, req)
+	s.databases[req.Name] = &Database{
+		DatabaseID: fmt.Sprintf("db-%d", len(s.created)),
+		Name:       req.Name,
+		Group:      req.Group,
+		IsSchema:   req.IsSchema,
+		Schema:     req.Schema,
+		Hostname:   req.Name + "-meow.turso.io",
+	}
+
+	return &CreateDatabaseResponse{Database: CreateDatabase{Name: req.Name, Hostname: req.Name + "-meow.turso.io"}}, nil
+}
+
+func (s *memoryDatabaseService) DeleteDatabase(ctx context.Context, dbName string) (*DeleteDatabaseResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.fail["delete "+dbName]; err != nil {
+		return nil, err
+	}
+
+	if _, ok := s.databases[dbName]; !ok {
+		return nil, newBadRequestError("database", "deleting", http.StatusNotFound)
+	}
+
+	s.deleted = append(s.deleted, dbName)
+	delete(s.databases, dbName)
+
+	return &DeleteDatabaseResponse{Database: dbName}, nil
+}
+
+func newMemoryClient(databases ...*Database) (*Client, *memoryDatabaseService) {
+	service := newMemoryDatabaseService(databases...)
+
+	client := NewMockClient()
+	client.cfg = &Config{PollInterval: time.Millisecond}
+	client.Database = service
+
+	return client, service
+}
+
+// memoryReady verifies databases of the memory service without resolving their hostnames
+var memoryReady = []DatabaseCondition{
+	DatabaseNotSleeping(),
+	DatabaseHostnameResolves(&mockHostResolver{hosts: map[string][]string{
+		restoreCopyName("tenant-db", time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)) + "-meow.turso.io": {"127.0.0.1"},
+		"tenant-db-meow.turso.io": {"127.0.0.1"},
+	}}),
+}
+
+func stepStatuses(steps []RestoreStep) []RestoreStepStatus {
+	out := []RestoreStepStatus{}
+
+	for _, step := range steps {
+		out = append(out, step.Status)
+	}
+
+	return out
+}
+
+func TestRestoreDatabase(t *testing.T) {
+	at := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	copyName := restoreCopyName("tenant-db", at)
+
+	client, databases := newMemoryClient(&Database{Name: "tenant-db", Group: "default"})
+
+	resp, err := client.RestoreDatabase(context.Background(), RestoreDatabaseRequest{Name: "tenant-db", At: at, Verify: memoryReady})
+	require.NoError(t, err)
+	assert.Equal(t, copyName, resp.CopyName)
+	assert.Equal(t, copyName, resp.Database.Name)
+	assert.Equal(t, []RestoreStepStatus{RestoreStepStatusDone, RestoreStepStatusDone}, stepStatuses(resp.Steps))
+	require.Len(t, databases.created, 1)
+	assert.Equal(t, CreateDatabaseRequest{
+		Name:  copyName,
+		Group: "default",
+		Seed:  SeedFromDatabaseAt("tenant-db", at),
+	}, databases.created[0])
+	assert.Empty(t, databases.deleted)
+}
+
+func TestRestoreDatabaseSwap(t *testing.T) {
+	at := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	copyName := restoreCopyName("tenant-db", at)
+
+	client, databases := newMemoryClient(&Database{Name: "tenant-db", Group: "default", Schema: "parent-db"})
+
+	// dry run makes no changes
+	resp, err := client.RestoreDatabase(context.Background(), RestoreDatabaseRequest{Name: "tenant-db", At: at, Swap: true, DryRun: true, Verify: memoryReady})
+	require.NoError(t, err)
+	assert.Len(t, resp.Steps, 6)
+	assert.Equal(t, slices.Repeat([]RestoreStepStatus{RestoreStepStatusPlanned}, 6), stepStatuses(resp.Steps))
+	assert.Nil(t, resp.Database)
+	assert.Empty(t, databases.created)
+
+	// swap the copy in
+	resp, err = client.RestoreDatabase(context.Background(), RestoreDatabaseRequest{Name: "tenant-db", At: at, Swap: true, Verify: memoryReady})
+	require.NoError(t, err)
+	assert.Equal(t, slices.Repeat([]RestoreStepStatus{RestoreStepStatusDone}, 6), stepStatuses(resp.Steps))
+	assert.Equal(t, "tenant-db", resp.Database.Name)
+	assert.Equal(t, []string{"tenant-db", copyName}, databases.deleted)
+	require.Len(t, databases.created, 2)
+	assert.Equal(t, CreateDatabaseRequest{
+		Name:   "tenant-db",
+		Group:  "default",
+		Schema: "parent-db",
+		Seed:   SeedFromDatabase(copyName),
+	}, databases.created[1])
+	assert.NotContains(t, databases.databases, copyName)
+}
+
+func TestRestoreDatabaseResume(t *testing.T) {
+	at := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	copyName := restoreCopyName("tenant-db", at)
+
+	client, databases := newMemoryClient(&Database{DatabaseID: "original", Name: "tenant-db", Group: "default"})
+
+	var saved RestoreState
+
+	checkpoint := func(_ context.Context, state RestoreState) error {
+		saved = state
+
+		return nil
+	}
+
+	// crash after the original was deleted
+	databases.fail["create tenant-db"] = errors.New("connection reset")
+
+	resp, err := client.RestoreDatabase(context.Background(), RestoreDatabaseRequest{
+		Name: "tenant-db", At: at, Swap: true, Verify: memoryReady, Checkpoint: checkpoint,
+	})
+	require.Error(t, err)
+	assert.Equal(t, []RestoreStepStatus{
+		RestoreStepStatusDone, RestoreStepStatusDone, RestoreStepStatusDone, RestoreStepStatusFailed,
+	}, stepStatuses(resp.Steps))
+	assert.NotContains(t, databases.databases, "tenant-db")
+	assert.Equal(t, RestoreState{
+		CopyName:   copyName,
+		OriginalID: "original",
+		Completed:  []RestoreStepName{RestoreStepCreateCopy, RestoreStepVerifyCopy, RestoreStepDeleteOriginal},
+	}, saved)
+
+	delete(databases.fail, "create tenant-db")
+
+	// the existing copy is not swapped in without the saved state
+	_, err = client.RestoreDatabase(context.Background(), RestoreDatabaseRequest{Name: "tenant-db", At: at, Swap: true, Verify: memoryReady})
+	require.ErrorIs(t, err, ErrRestoreInProgress)
+
+	// resume
+	resp, err = client.RestoreDatabase(context.Background(), RestoreDatabaseRequest{
+		Name: "tenant-db", At: at, Swap: true, Verify: memoryReady, State: &saved, Checkpoint: checkpoint,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []RestoreStepStatus{
+		RestoreStepStatusSkipped, RestoreStepStatusDone, RestoreStepStatusSkipped,
+		RestoreStepStatusDone, RestoreStepStatusDone, RestoreStepStatusDone,
+	}, stepStatuses(resp.Steps))
+	assert.Equal(t, "default", databases.databases["tenant-db"].Group)
+	assert.NotContains(t, databases.databases, copyName)
+
+	// resuming a finished restore does nothing
+	created := len(databases.created)
+
+	resp, err = client.RestoreDatabase(context.Background(), RestoreDatabaseRequest{
+		Name: "tenant-db", At: at, Swap: true, Verify: memoryReady, State: &saved,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Steps)
+	assert.Equal(t, "tenant-db", resp.Database.Name)
+	assert.Len(t, databases.created, created)
+
+	// state of another restore
+	_, err = client.RestoreDatabase(context.Background(), RestoreDatabaseRequest{
+		Name: "tenant-db", At: at.Add(time.Hour), Swap: true, Verify: memoryReady, State: &saved,
+	})
+	require.ErrorContains(t, err, "does not belong to this database and restore point")
+
+	// nothing to restore from
+	client, _ = newMemoryClient()
+
+	resp, err = client.RestoreDatabase(context.Background(), RestoreDatabaseRequest{Name: "tenant-db", At: at, Verify: memoryReady})
+	require.ErrorIs(t, err, ErrNotFound)
+	assert.Nil(t, resp)
+}
+
+func TestRestoreDatabaseResumeAfterRecreate(t *testing.T) {
+	at := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	copyName := restoreCopyName("tenant-db", at)
+
+	client, databases := newMemoryClient(&Database{DatabaseID: "original", Name: "tenant-db", Group: "default"})
+
+	var saved RestoreState
+
+	// crash after the original was recreated, before the copy was deleted
+	databases.fail["delete "+copyName] = errors.New("connection reset")
+
+	_, err := client.RestoreDatabase(context.Background(), RestoreDatabaseRequest{
+		Name: "tenant-db", At: at, Swap: true, Verify: memoryReady,
+		Checkpoint: func(_ context.Context, state RestoreState) error {
+			saved = state
+
+			return nil
+		},
+	})
+	require.Error(t, err)
+
+	recreated := databases.databases["tenant-db"].DatabaseID
+	assert.NotEqual(t, "original", recreated)
+
+	// the checkpoint of the recreate step was lost, the recreated database is still recognized by its id
+	saved.Completed = saved.Completed[:3]
+
+	delete(databases.fail, "delete "+copyName)
+
+	resp, err := client.RestoreDatabase(context.Background(), RestoreDatabaseRequest{
+		Name: "tenant-db", At: at, Swap: true, Verify: memoryReady, State: &saved,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []RestoreStepStatus{
+		RestoreStepStatusSkipped, RestoreStepStatusDone, RestoreStepStatusSkipped,
+		RestoreStepStatusSkipped, RestoreStepStatusDone, RestoreStepStatusDone,
+	}, stepStatuses(resp.Steps))
+
+	// the live database was not deleted again
+	assert.Equal(t, []string{"tenant-db", copyName}, databases.deleted)
+	assert.Equal(t, recreated, databases.databases["tenant-db"].DatabaseID)
+	assert.NotContains(t, databases.databases, copyName)
+}
+
+func TestRestoreDatabaseVerifyFails(t *testing.T) {
+	at := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	copyName := restoreCopyName("tenant-db", at)
+
+	client, databases := newMemoryClient(&Database{Name: "tenant-db", Group: "default"})
+
+	// the copy never resolves, the original must not be deleted
+	resp, err := client.RestoreDatabase(context.Background(), RestoreDatabaseRequest{
+		Name:   "tenant-db",
+		At:     at,
+		Swap:   true,
+		Wait:   WaitOptions{Timeout: 20 * time.Millisecond},
+		Verify: []DatabaseCondition{DatabaseHostnameResolves(&mockHostResolver{})},
+	})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	var timeoutErr *WaitTimeoutError[Database]
+	require.ErrorAs(t, err, &timeoutErr)
+	assert.Equal(t, copyName, timeoutErr.LastState.Name)
+
+	assert.Equal(t, []RestoreStepStatus{RestoreStepStatusDone, RestoreStepStatusFailed}, stepStatuses(resp.Steps))
+	assert.Contains(t, databases.databases, "tenant-db")
+	assert.Empty(t, databases.deleted)
+	assert.Nil(t, resp.Database)
+
+	// a dry run with the existing copy does not report a restored database
+	resp, err = client.RestoreDatabase(context.Background(), RestoreDatabaseRequest{Name: "tenant-db", At: at, DryRun: true, Verify: memoryReady})
+	require.NoError(t, err)
+	assert.Equal(t, []RestoreStepStatus{RestoreStepStatusSkipped, RestoreStepStatusPlanned}, stepStatuses(resp.Steps))
+	assert.Nil(t, resp.Database)
+}
+
+func TestRestoreCopyName(t *testing.T) {
+	at := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	copyName := restoreCopyName("tenant-db", at)
+	assert.True(t, strings.HasPrefix(copyName, "tenant-db-r"), copyName)
+	assert.Len(t, copyName, len("tenant-db-r")+restoreCopyHashLength)
+	assert.Equal(t, copyName, restoreCopyName("tenant-db", at))
+
+	long := restoreCopyName("abcdefghijklmnopqrstuvwxyz012345", at)
+	assert.LessOrEqual(t, len(long), maxNameLength)
+	require.NoError(t, validateDatabaseName(long))
+
+	assert.NotEqual(t, restoreCopyName("tenant-db", at), restoreCopyName("tenant-db", at.Add(time.Second)))
+
+	// long names sharing a prefix, e.g. generated tenant names, get different copies
+	a := restoreCopyName("tenant-acme-corporation-k3a1b2c3", at)
+	b := restoreCopyName("tenant-acme-corporation-k3x9z8y7", at)
+	assert.NotEqual(t, a, b)
+	require.NoError(t, validateDatabaseName(a))
+	require.NoError(t, validateDatabaseName(b))
+}
+
+func TestRestoreDatabaseSharedPrefix(t *testing.T) {
+	at := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	tenantA := "tenant-acme-corporation-k3a1b2c3"
+	tenantB := "tenant-acme-corporation-k3x9z8y7"
+
+	client, databases := newMemoryClient(
+		&Database{Name: tenantA, Group: "default"},
+		&Database{Name: tenantB, Group: "default"},
+	)
+
+	// the copy of tenant a is kept while tenant b is restored
+	_, err := client.RestoreDatabase(context.Background(), RestoreDatabaseRequest{
+		Name:   tenantA,
+		At:     at,
+		Verify: []DatabaseCondition{DatabaseNotSleeping()},
+	})
+	require.NoError(t, err)
+
+	_, err = client.RestoreDatabase(context.Background(), RestoreDatabaseRequest{
+		Name:   tenantB,
+		At:     at,
+		Swap:   true,
+		Verify: []DatabaseCondition{DatabaseNotSleeping()},
+	})
+	require.NoError(t, err)
+
+	// tenant b is recreated from its own copy
+	require.Len(t, databases.created, 3)
+	assert.Equal(t, SeedFromDatabaseAt(tenantA, at), databases.created[0].Seed)
+	assert.Equal(t, SeedFromDatabaseAt(tenantB, at), databases.created[1].Seed)
+	assert.NotEqual(t, databases.created[0].Name, databases.created[1].Name)
+	assert.Equal(t, CreateDatabaseRequest{
+		Name:  tenantB,
+		Group: "default",
+		Seed:  SeedFromDatabase(databases.created[1].Name),
+	}, databases.created[2])
+	assert.Contains(t, databases.databases, databases.created[0].Name)
+}
+
+func TestValidateRestoreDatabaseRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		request RestoreDatabaseRequest
+		wantErr error
+	}{
+		{
+			name:    "valid request",
+			request: RestoreDatabaseRequest{Name: "tenant-db", At: time.Now().Add(-time.Hour)},
+			wantErr: nil,
+		},
+		{
+			name:    "invalid name",
+			request: RestoreDatabaseRequest{Name: "Tenant DB", At: time.Now().Add(-time.Hour)},
+			wantErr: ErrInvalidDatabaseName,
+		},
+		{
+			name:    "missing restore point",
+			request: RestoreDatabaseRequest{Name: "tenant-db"},
+			wantErr: &MissingRequiredFieldError{RequiredField: "at"},
+		},
+		{
+			name:    "restore point in the future",
+			request: RestoreDatabaseRequest{Name: "tenant-db", At: time.Now().Add(time.Hour)},
+			wantErr: &InvalidFieldError{Field: "at", Message: "must not be in the future"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateRestoreDatabaseRequest(tt.request)
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.wantErr.Error())
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package turso
+
+import (
+	"net/url"
+	"regexp"
+	"time"
+)
+
+const regexSizeLimit = "^(?i)[0-9]+(b|kb|mb|gb|tb)?$"
+
+// SeedType is the type of source a database is created from
+type SeedType string
+
+const (
+	// SeedTypeDatabase copies an existing database, optionally at a point in time
+	SeedTypeDatabase SeedType = "database"
+	// SeedTypeDump loads a SQL dump from a URL
+	SeedTypeDump SeedType = "dump"
+	// SeedTypeUpload waits for a SQLite database file to be uploaded after the database is created
+	SeedTypeUpload SeedType = "database_upload"
+)
+
+// DatabaseSeed is the source a database is created from
+type DatabaseSeed struct {
+	// Type is the type of seed
+	Type SeedType `json:"type"`
+	// Name is the name of the database to copy, required for database seeds
+	Name string `json:"name,omitempty"`
+	// URL is the URL of the dump, required for dump seeds
+	URL string `json:"url,omitempty"`
+	// Timestamp is the point in time to restore the database from, only used for database seeds
+	Timestamp *time.Time `json:"timestamp,omitempty"`
+}
+
+// SeedFromDatabase returns a seed that copies the current state of an existing database
+func SeedFromDatabase(name string) *DatabaseSeed {
+	return &DatabaseSeed{
+		Type: SeedTypeDatabase,
+		Name: name,
+	}
+}
+
+// SeedFromDatabaseAt returns a seed that restores an existing database at a point in time
+func SeedFromDatabaseAt(name string, at time.Time) *DatabaseSeed {
+	at = at.UTC()
+
+	return &DatabaseSeed{
+		Type:      SeedTypeDatabase,
+		Name:      name,
+		Timestamp: &at,
+	}
+}
+
+// SeedFromDump returns a seed that loads a SQL dump from a URL
+func SeedFromDump(dumpURL string) *DatabaseSeed {
+	return &DatabaseSeed{
+		Type: SeedTypeDump,
+		URL:  dumpURL,
+	}
+}
+
+// SeedFromUpload returns a seed that waits for a SQLite database file to be uploaded
+func SeedFromUpload() *DatabaseSeed {
+	return &DatabaseSeed{
+		Type: SeedTypeUpload,
+	}
+}
+
+// validateCreateDatabaseRequest validates the database create request including the seed options
+func validateCreateDatabaseRequest(req CreateDatabaseRequest) error {
+	if err := validateDatabaseName(req.Name); err != nil {
+		return err
+	}
+
+	if req.Schema != "" {
+		if req.IsSchema {
+			return newInvalidFieldError("schema", "a schema database cannot use another schema database")
+		}
+
+		if err := validateDatabaseName(req.Schema); err != nil {
+			return err
+		}
+	}
+
+	if req.SizeLimit != "" {
+		if match, _ := regexp.MatchString(regexSizeLimit, req.SizeLimit); !match {
+			return newInvalidFieldError("size_limit", "must be a size, e.g. 256mb")
+		}
+	}
+
+	if req.Seed != nil {
+		return validateDatabaseSeed(*req.Seed)
+	}
+
+	return nil
+}
+
+// validateDatabaseSeed validates the fields required by the seed type are set
+func validateDatabaseSeed(seed DatabaseSeed) error {
+	switch seed.Type {
+	case SeedTypeDatabase:
+		if seed.Name == "" {
+			return newMissingRequiredFieldError("seed name")
+		}
+
+		if err := validateDatabaseName(seed.Name); err != nil {
+			return err
+		}
+
+		if seed.Timestamp != nil && seed.Timestamp.After(time.Now()) {
+			return newInvalidFieldError("seed timestamp", "must not be in the future")
+		}
+	case SeedTypeDump:
+		if seed.URL == "" {
+			return newMissingRequiredFieldError("seed url")
+		}
+
+		u, err := url.Parse(seed.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return newInvalidFieldError("seed url", "must be a valid http or https URL")
+		}
+	case SeedTypeUpload:
+	case "":
+		return newMissingRequiredFieldError("seed type")
+	default:
+		return newInvalidFieldError("seed type", "valid options are database, dump or database_upload")
+	}
+
+	if seed.Type != SeedTypeDatabase && (seed.Name != "" || seed.Timestamp != nil) {
+		return newInvalidFieldError("seed", "name and timestamp are only valid for database seeds")
+	}
+
+	if seed.Type != SeedTypeDump && seed.URL != "" {
+		return newInvalidFieldError("seed", "url is only valid for dump seeds")
+	}
+
+	return nil
+}
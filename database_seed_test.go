@@ -0,0 +1,144 @@
+package turso
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDatabaseSeedMarshalJSON(t *testing.T) {
+	at := time.Date(2024, time.January, 1, 10, 30, 0, 0, time.FixedZone("CET", 3600))
+
+	tests := []struct {
+		name     string
+		input    CreateDatabaseRequest
+		expected string
+	}{
+		{
+			name:     "no seed",
+			input:    CreateDatabaseRequest{Name: "my-db", Group: "default"},
+			expected: `{"group":"default","is_schema":false,"name":"my-db"}`,
+		},
+		{
+			name:     "database seed",
+			input:    CreateDatabaseRequest{Name: "my-db", Group: "default", Seed: SeedFromDatabase("other-db")},
+			expected: `{"group":"default","is_schema":false,"name":"my-db","seed":{"type":"database","name":"other-db"}}`,
+		},
+		{
+			name:     "point in time seed",
+			input:    CreateDatabaseRequest{Name: "my-db", Group: "default", Seed: SeedFromDatabaseAt("other-db", at)},
+			expected: `{"group":"default","is_schema":false,"name":"my-db","seed":{"type":"database","name":"other-db","timestamp":"2024-01-01T09:30:00Z"}}`,
+		},
+		{
+			name:     "dump seed with size limit and schema",
+			input:    CreateDatabaseRequest{Name: "my-db", Group: "default", Seed: SeedFromDump("https://example.com/dump.sql"), SizeLimit: "1gb", Schema: "parent-db"},
+			expected: `{"group":"default","is_schema":false,"name":"my-db","seed":{"type":"dump","url":"https://example.com/dump.sql"},"size_limit":"1gb","schema":"parent-db"}`,
+		},
+		{
+			name:     "upload seed",
+			input:    CreateDatabaseRequest{Name: "my-db", Group: "default", Seed: SeedFromUpload()},
+			expected: `{"group":"default","is_schema":false,"name":"my-db","seed":{"type":"database_upload"}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := json.Marshal(tt.input)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.expected, string(out))
+		})
+	}
+}
+
+func TestValidateCreateDatabaseRequest(t *testing.T) {
+	future := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name    string
+		request CreateDatabaseRequest
+		wantErr error
+	}{
+		{
+			name:    "valid request",
+			request: CreateDatabaseRequest{Name: "my-db"},
+			wantErr: nil,
+		},
+		{
+			name:    "valid request, all options",
+			request: CreateDatabaseRequest{Name: "my-db", Schema: "parent-db", SizeLimit: "256MB", Seed: SeedFromDatabaseAt("other-db", time.Now().Add(-time.Hour))},
+			wantErr: nil,
+		},
+		{
+			name:    "invalid name",
+			request: CreateDatabaseRequest{Name: "My DB"},
+			wantErr: ErrInvalidDatabaseName,
+		},
+		{
+			name:    "schema database with a schema",
+			request: CreateDatabaseRequest{Name: "my-db", IsSchema: true, Schema: "parent-db"},
+			wantErr: &InvalidFieldError{Field: "schema", Message: "a schema database cannot use another schema database"},
+		},
+		{
+			name:    "invalid size limit",
+			request: CreateDatabaseRequest{Name: "my-db", SizeLimit: "lots"},
+			wantErr: &InvalidFieldError{Field: "size_limit", Message: "must be a size, e.g. 256mb"},
+		},
+		{
+			name:    "database seed missing name",
+			request: CreateDatabaseRequest{Name: "my-db", Seed: SeedFromDatabase("")},
+			wantErr: &MissingRequiredFieldError{RequiredField: "seed name"},
+		},
+		{
+			name:    "database seed in the future",
+			request: CreateDatabaseRequest{Name: "my-db", Seed: SeedFromDatabaseAt("other-db", future)},
+			wantErr: &InvalidFieldError{Field: "seed timestamp", Message: "must not be in the future"},
+		},
+		{
+			name:    "dump seed missing url",
+			request: CreateDatabaseRequest{Name: "my-db", Seed: SeedFromDump("")},
+			wantErr: &MissingRequiredFieldError{RequiredField: "seed url"},
+		},
+		{
+			name:    "dump seed invalid url",
+			request: CreateDatabaseRequest{Name: "my-db", Seed: SeedFromDump("file:///tmp/dump.sql")},
+			wantErr: &InvalidFieldError{Field: "seed url", Message: "must be a valid http or https URL"},
+		},
+		{
+			name:    "upload seed with url",
+			request: CreateDatabaseRequest{Name: "my-db", Seed: &DatabaseSeed{Type: SeedTypeUpload, URL: "https://example.com/dump.sql"}},
+			wantErr: &InvalidFieldError{Field: "seed", Message: "url is only valid for dump seeds"},
+		},
+		{
+			name:    "dump seed with name",
+			request: CreateDatabaseRequest{Name: "my-db", Seed: &DatabaseSeed{Type: SeedTypeDump, URL: "https://example.com/dump.sql", Name: "other-db"}},
+			wantErr: &InvalidFieldError{Field: "seed", Message: "name and timestamp are only valid for database seeds"},
+		},
+		{
+			name:    "missing seed type",
+			request: CreateDatabaseRequest{Name: "my-db", Seed: &DatabaseSeed{}},
+			wantErr: &MissingRequiredFieldError{RequiredField: "seed type"},
+		},
+		{
+			name:    "invalid seed type",
+			request: CreateDatabaseRequest{Name: "my-db", Seed: &DatabaseSeed{Type: "backup"}},
+			wantErr: &InvalidFieldError{Field: "seed type", Message: "valid options are database, dump or database_upload"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateCreateDatabaseRequest(tt.request)
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.wantErr.Error())
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"io"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -68,6 +69,355 @@ func TestCreateDatabase(t *testing.T) {
 	assert.Nil(t, resp)
 }
 
+func TestGetDatabaseConfig(t *testing.T) {
+	body := `{"size_limit":"256mb","allow_attach":true,"block_reads":false,"block_writes":true,"delete_protection":true}`
+	client := &Client{
+		cfg: &Config{
+			BaseURL: "http://localhost",
+			OrgName: "meow",
+		},
+		client: MockHTTPRequestDoerFunc(func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Equal(t, "/v1/organizations/meow/databases/my-db/configuration", req.URL.Path)
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewReader([]byte(body))),
+			}, nil
+		}),
+	}
+
+	// happy path
+	databaseService := DatabaseService{client: client}
+
+	resp, err := databaseService.GetDatabaseConfig(context.Background(), "my-db")
+	require.NoError(t, err)
+	assert.Equal(t, &DatabaseConfig{
+		SizeLimit:        "256mb",
+		AllowAttach:      true,
+		BlockWrites:      true,
+		DeleteProtection: true,
+	}, resp)
+
+	// test error, invalid database name
+	resp, err = databaseService.GetDatabaseConfig(context.Background(), "")
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+}
+
+func TestUpdateDatabaseConfig(t *testing.T) {
+	client := &Client{
+		cfg: &Config{
+			BaseURL: "http://localhost",
+			OrgName: "meow",
+		},
+		client: MockHTTPRequestDoerFunc(func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, http.MethodPatch, req.Method)
+			assert.Equal(t, "/v1/organizations/meow/databases/my-db/configuration", req.URL.Path)
+
+			body, err := io.ReadAll(req.Body)
+			require.NoError(t, err)
+			assert.JSONEq(t, `{"block_writes":true}`, string(body))
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewReader([]byte(`{"block_writes":true}`))),
+			}, nil
+		}),
+	}
+
+	// happy path
+	databaseService := DatabaseService{client: client}
+	blockWrites := true
+
+	resp, err := databaseService.UpdateDatabaseConfig(context.Background(), UpdateDatabaseConfigRequest{
+		DatabaseName: "my-db",
+		BlockWrites:  &blockWrites,
+	})
+	require.NoError(t, err)
+	assert.True(t, resp.BlockWrites)
+
+	// test error, invalid database name
+	resp, err = databaseService.UpdateDatabaseConfig(context.Background(), UpdateDatabaseConfigRequest{DatabaseName: "My DB"})
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+}
+
+func TestGetDatabaseUsage(t *testing.T) {
+	body := `{"database":{"uuid":"0eb771dd-6906-11ee-8553-eaa7715aeaf2","instances":[{"uuid":"cd831986-94e5-11ee-a6fe-7a52e1f7759a","usage":{"rows_read":80,"rows_written":6,"storage_bytes":4096,"bytes_synced":512}},{"uuid":"d7a5cd92-94e5-11ee-a6fe-7a52e1f7759a","usage":{"rows_read":20,"rows_written":4,"storage_bytes":4096,"bytes_synced":0}}],"total":{"rows_read":100,"rows_written":10,"storage_bytes":8192,"bytes_synced":512}}}`
+	client := &Client{
+		cfg: &Config{
+			BaseURL: "http://localhost",
+			OrgName: "meow",
+		},
+		client: MockHTTPRequestDoerFunc(func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Equal(t, "/v1/organizations/meow/databases/my-db/usage", req.URL.Path)
+			assert.Equal(t, "2024-01-01T00:00:00Z", req.URL.Query().Get("from"))
+			assert.Equal(t, "2024-02-01T00:00:00Z", req.URL.Query().Get("to"))
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewReader([]byte(body))),
+			}, nil
+		}),
+	}
+
+	from := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)
+
+	// happy path
+	databaseService := DatabaseService{client: client}
+
+	resp, err := databaseService.GetDatabaseUsage(context.Background(), "my-db", from, to)
+	require.NoError(t, err)
+	require.Len(t, resp.Database.Instances, 2)
+	assert.Equal(t, int64(80), resp.Database.Instances[0].Usage.RowsRead)
+	assert.Equal(t, Usage{RowsRead: 100, RowsWritten: 10, StorageBytes: 8192, BytesSynced: 512}, resp.Database.Total)
+
+	// test error, to before from
+	resp, err = databaseService.GetDatabaseUsage(context.Background(), "my-db", to, from)
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+}
+
+func TestGetDatabaseStats(t *testing.T) {
+	body := `{"top_queries":[{"query":"SELECT * FROM users","rows_read":1000,"rows_written":0},{"query":"INSERT INTO users (name) VALUES (?)","rows_read":0,"rows_written":25}]}`
+	client := &Client{
+		cfg: &Config{
+			BaseURL: "http://localhost",
+			OrgName: "meow",
+		},
+		client: MockHTTPRequestDoerFunc(func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Equal(t, "/v1/organizations/meow/databases/my-db/stats", req.URL.Path)
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewReader([]byte(body))),
+			}, nil
+		}),
+	}
+
+	// happy path
+	databaseService := DatabaseService{client: client}
+
+	resp, err := databaseService.GetDatabaseStats(context.Background(), "my-db")
+	require.NoError(t, err)
+	require.Len(t, resp.TopQueries, 2)
+	assert.Equal(t, QueryStats{Query: "SELECT * FROM users", RowsRead: 1000}, resp.TopQueries[0])
+	assert.Equal(t, int64(25), resp.TopQueries[1].RowsWritten)
+
+	// test error, invalid database name
+	resp, err = databaseService.GetDatabaseStats(context.Background(), "")
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+}
+
+func TestListInstances(t *testing.T) {
+	body := `{"instances":[{"uuid":"cd831986-94e5-11ee-a6fe-7a52e1f7759a","name":"lhr","type":"primary","region":"lhr","hostname":"lhr-my-db-meow.turso.io"},{"uuid":"d7a5cd92-94e5-11ee-a6fe-7a52e1f7759a","name":"ams","type":"replica","region":"ams","hostname":"ams-my-db-meow.turso.io"}]}`
+	client := &Client{
+		cfg: &Config{
+			BaseURL: "http://localhost",
+			OrgName: "meow",
+		},
+		client: MockHTTPRequestDoerFunc(func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Equal(t, "/v1/organizations/meow/databases/my-db/instances", req.URL.Path)
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewReader([]byte(body))),
+			}, nil
+		}),
+	}
+
+	// happy path
+	databaseService := DatabaseService{client: client}
+
+	resp, err := databaseService.ListInstances(context.Background(), "my-db")
+	require.NoError(t, err)
+	require.Len(t, resp.Instances, 2)
+	assert.Equal(t, InstanceTypePrimary, resp.Instances[0].Type)
+	assert.Equal(t, Instance{
+		UUID:     "d7a5cd92-94e5-11ee-a6fe-7a52e1f7759a",
+		Name:     "ams",
+		Type:     InstanceTypeReplica,
+		Region:   "ams",
+		Hostname: "ams-my-db-meow.turso.io",
+	}, resp.Instances[1])
+
+	// test error, invalid database name
+	resp, err = databaseService.ListInstances(context.Background(), "")
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+}
+
+func TestGetInstance(t *testing.T) {
+	body := `{"instance":{"uuid":"d7a5cd92-94e5-11ee-a6fe-7a52e1f7759a","name":"ams","type":"replica","region":"ams","hostname":"ams-my-db-meow.turso.io"}}`
+	client := &Client{
+		cfg: &Config{
+			BaseURL: "http://localhost",
+			OrgName: "meow",
+		},
+		client: MockHTTPRequestDoerFunc(func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Equal(t, "/v1/organizations/meow/databases/my-db/instances/ams", req.URL.Path)
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewReader([]byte(body))),
+			}, nil
+		}),
+	}
+
+	// happy path
+	databaseService := DatabaseService{client: client}
+
+	resp, err := databaseService.GetInstance(context.Background(), "my-db", "ams")
+	require.NoError(t, err)
+	assert.Equal(t, "ams", resp.Instance.Name)
+	assert.Equal(t, InstanceTypeReplica, resp.Instance.Type)
+
+	// test error, missing instance name
+	resp, err = databaseService.GetInstance(context.Background(), "my-db", "")
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+}
+
+func TestDeleteInstance(t *testing.T) {
+	status := http.StatusOK
+
+	client := &Client{
+		cfg: &Config{
+			BaseURL: "http://localhost",
+			OrgName: "meow",
+		},
+		client: MockHTTPRequestDoerFunc(func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, http.MethodDelete, req.Method)
+			assert.Equal(t, "/v1/organizations/meow/databases/my-db/instances/ams", req.URL.Path)
+
+			return &http.Response{
+				StatusCode: status,
+				Body:       http.NoBody,
+			}, nil
+		}),
+	}
+
+	// happy path
+	databaseService := DatabaseService{client: client}
+
+	err := databaseService.DeleteInstance(context.Background(), "my-db", "ams")
+	require.NoError(t, err)
+
+	// test error, not found
+	status = http.StatusNotFound
+
+	err = databaseService.DeleteInstance(context.Background(), "my-db", "ams")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	// test error, missing instance name
+	err = databaseService.DeleteInstance(context.Background(), "my-db", "")
+	assert.Error(t, err)
+}
+
+func TestTransferDatabase(t *testing.T) {
+	dbBody := `{"database":{"Name":"my-db","group":"tenants"}}`
+
+	newClient := func(groupStatus, transferStatus int) *Client {
+		return &Client{
+			cfg: &Config{
+				BaseURL: "http://localhost",
+				OrgName: "shared",
+			},
+			client: MockHTTPRequestDoerFunc(func(req *http.Request) (*http.Response, error) {
+				status := http.StatusOK
+				body := `{}`
+
+				switch req.URL.Path {
+				case "/v1/organizations/shared/databases/my-db":
+					body = dbBody
+				case "/v1/organizations/dedicated/groups/tenants":
+					status = groupStatus
+					body = `{"group":{"name":"tenants"}}`
+				case "/v1/organizations/shared/databases/my-db/transfer":
+					reqBody, err := io.ReadAll(req.Body)
+					require.NoError(t, err)
+					assert.JSONEq(t, `{"organization":"dedicated"}`, string(reqBody))
+
+					status = transferStatus
+					body = `{"Name":"my-db","group":"tenants"}`
+				default:
+					t.Fatalf("unexpected request %s", req.URL.Path)
+				}
+
+				return &http.Response{
+					StatusCode: status,
+					Body:       io.NopCloser(bytes.NewReader([]byte(body))),
+				}, nil
+			}),
+		}
+	}
+
+	// happy path
+	databaseService := DatabaseService{client: newClient(http.StatusOK, http.StatusOK)}
+
+	resp, err := databaseService.TransferDatabase(context.Background(), "my-db", "dedicated")
+	require.NoError(t, err)
+	assert.Equal(t, "my-db", resp.Name)
+	assert.Equal(t, "tenants", resp.Group)
+
+	// test error, group mismatch
+	databaseService = DatabaseService{client: newClient(http.StatusNotFound, http.StatusOK)}
+
+	resp, err = databaseService.TransferDatabase(context.Background(), "my-db", "dedicated")
+	assert.ErrorIs(t, err, ErrGroupMismatch)
+	assert.Nil(t, resp)
+
+	// test error, permission denied
+	databaseService = DatabaseService{client: newClient(http.StatusOK, http.StatusForbidden)}
+
+	resp, err = databaseService.TransferDatabase(context.Background(), "my-db", "dedicated")
+	assert.ErrorIs(t, err, ErrPermissionDenied)
+	assert.Nil(t, resp)
+
+	// test error, conflict
+	databaseService = DatabaseService{client: newClient(http.StatusOK, http.StatusConflict)}
+
+	resp, err = databaseService.TransferDatabase(context.Background(), "my-db", "dedicated")
+	assert.ErrorIs(t, err, ErrConflict)
+	assert.Nil(t, resp)
+
+	// test error, same organization
+	resp, err = databaseService.TransferDatabase(context.Background(), "my-db", "shared")
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	// database without a group skips the group check, a group lookup would fail the test
+	dbBody = `{"database":{"Name":"my-db"}}`
+	databaseService = DatabaseService{client: newClient(http.StatusNotFound, http.StatusOK)}
+
+	resp, err = databaseService.TransferDatabase(context.Background(), "my-db", "dedicated")
+	require.NoError(t, err)
+	assert.Equal(t, "my-db", resp.Name)
+
+	// test error, database missing from the response
+	dbBody = `{}`
+
+	resp, err = databaseService.TransferDatabase(context.Background(), "my-db", "dedicated")
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.Nil(t, resp)
+}
+
+func TestGetDatabaseUsageEndpoint(t *testing.T) {
+	endpoint := getDatabaseUsageEndpoint("http://localhost", "meow", "my-db", time.Time{}, time.Time{})
+	assert.Equal(t, "http://localhost/v1/organizations/meow/databases/my-db/usage", endpoint)
+
+	from := time.Date(2024, time.January, 1, 1, 0, 0, 0, time.FixedZone("CET", 3600))
+	endpoint = getDatabaseUsageEndpoint("http://localhost", "meow", "my-db", from, time.Time{})
+	assert.Equal(t, "http://localhost/v1/organizations/meow/databases/my-db/usage?from=2024-01-01T00%3A00%3A00Z", endpoint)
+}
+
 func TestValidateDatabaseName(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -92,11 +92,17 @@ func (s *DatabaseTokensService) CreateDatabaseToken(ctx context.Context, req Cre
 // validateDatabaseTokenRequest ensures the authorization and expiration are valid
 // in the given request before making the API call
 func validateDatabaseTokenRequest(req CreateDatabaseTokenRequest) error {
-	if !isValidExpiration(req.Expiration) {
+	return validateTokenOptions(req.Expiration, req.Authorization)
+}
+
+// validateTokenOptions ensures the expiration and authorization shared by all
+// token requests are valid
+func validateTokenOptions(expiration, authorization string) error {
+	if !isValidExpiration(expiration) {
 		return ErrExpirationInvalid
 	}
 
-	if !isValidAuthorization(req.Authorization) {
+	if !isValidAuthorization(authorization) {
 		return ErrAuthorizationInvalid
 	}
 
@@ -0,0 +1,243 @@
+package turso
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+)
+
+const (
+	uploadEndpoint        = "v1/upload"
+	uploadTokenExpiration = "1h"
+	sqliteHeader          = "SQLite format 3\x00"
+)
+
+// UploadDatabaseRequest is the struct to create a database seeded from a SQLite database file
+type UploadDatabaseRequest struct {
+	// Name is the name of the database to create
+	Name string
+	// Group is the group to create the database in
+	Group string
+	// SizeLimit is the maximum size of the database, e.g. 256mb
+	SizeLimit string
+	// Path is the path of the SQLite file to upload, either Path or Reader must be set
+	Path string
+	// Reader is the SQLite database to upload, either Path or Reader must be set
+	Reader io.Reader
+	// Size is the size of Reader in bytes, when set the upload fails if the size does not match
+	// it is read from the file when Path is used
+	Size int64
+	// MaxSize is the maximum size of the upload in bytes, no limit when zero
+	MaxSize int64
+	// SHA256 is the expected hex encoded sha256 checksum of the file, not verified when empty
+	SHA256 string
+	// Progress is called as the file is uploaded with the bytes uploaded so far and the total size, or -1 when unknown
+	Progress func(uploaded, total int64)
+}
+
+// UploadDatabaseResponse is the result of uploading a SQLite database file
+type UploadDatabaseResponse struct {
+	// Database is the created database
+	Database CreateDatabase
+	// Size is the number of bytes uploaded
+	Size int64
+	// SHA256 is the hex encoded sha256 checksum of the uploaded file
+	SHA256 string
+}
+
+// UploadDatabase creates a database seeded from a SQLite file and streams the file to it
+// the file is verified while it is streamed, the upload is aborted when it exceeds the max size or the checksum
+// does not match and the created database is deleted when the upload fails
+func (c *Client) UploadDatabase(ctx context.Context, req UploadDatabaseRequest) (*UploadDatabaseResponse, error) {
+	if err := validateUploadDatabaseRequest(req); err != nil {
+		return nil, err
+	}
+
+	reader := req.Reader
+	total := req.Size
+
+	if req.Path != "" {
+		f, err := os.Open(req.Path)
+		if err != nil {
+			return nil, err
+		}
+
+		defer f.Close()
+
+		info, err := f.Stat()
+		if err != nil {
+			return nil, err
+		}
+
+		reader = f
+		total = info.Size()
+	}
+
+	if total <= 0 {
+		total = -1
+	}
+
+	if req.MaxSize > 0 && total > req.MaxSize {
+		return nil, ErrUploadTooLarge
+	}
+
+	// check the header before creating the database to fail fast on invalid files
+	buffered := bufio.NewReader(reader)
+
+	header, err := buffered.Peek(len(sqliteHeader))
+	if err != nil || !bytes.Equal(header, []byte(sqliteHeader)) {
+		return nil, ErrInvalidSQLiteFile
+	}
+
+	db, err := c.Database.CreateDatabase(ctx, CreateDatabaseRequest{
+		Name:      req.Name,
+		Group:     req.Group,
+		SizeLimit: req.SizeLimit,
+		Seed:      SeedFromUpload(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	upload := &uploadReader{
+		reader:   buffered,
+		hash:     sha256.New(),
+		total:    total,
+		maxSize:  req.MaxSize,
+		expected: req.SHA256,
+		progress: req.Progress,
+	}
+
+	if err := c.uploadDatabaseFile(ctx, db.Database, upload); err != nil {
+		// remove the empty database so the upload can be retried with the same name
+		if _, delErr := c.Database.DeleteDatabase(context.WithoutCancel(ctx), req.Name); delErr != nil {
+			return nil, errors.Join(err, delErr)
+		}
+
+		return nil, err
+	}
+
+	return &UploadDatabaseResponse{
+		Database: db.Database,
+		Size:     upload.read,
+		SHA256:   hex.EncodeToString(upload.hash.Sum(nil)),
+	}, nil
+}
+
+// uploadDatabaseFile streams the file to the upload endpoint of the database using a short lived token
+func (c *Client) uploadDatabaseFile(ctx context.Context, db CreateDatabase, upload *uploadReader) error {
+	token, err := c.DatabaseTokens.CreateDatabaseToken(ctx, CreateDatabaseTokenRequest{
+		DatabaseName:  db.Name,
+		Expiration:    uploadTokenExpiration,
+		Authorization: FullAccess,
+	})
+	if err != nil {
+		return err
+	}
+
+	endpoint := fmt.Sprintf("https://%s/%s", db.Hostname, uploadEndpoint)
+
+	resp, err := c.doRequestWithToken(ctx, http.MethodPost, endpoint, token.JWT, &RequestBody{
+		Reader:        upload,
+		ContentLength: upload.total,
+	})
+	if err != nil {
+		// surface the verification error instead of the wrapped transport error
+		if upload.err != nil {
+			return upload.err
+		}
+
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if upload.err != nil {
+		return upload.err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return newBadRequestError("database", "uploading", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// uploadReader verifies the size and checksum of the file while it is read
+// an error is returned instead of io.EOF when verification fails so the upload is never completed
+type uploadReader struct {
+	reader   io.Reader
+	hash     hash.Hash
+	read     int64
+	total    int64
+	maxSize  int64
+	expected string
+	progress func(uploaded, total int64)
+	err      error
+}
+
+// Read satisfies the io.Reader interface
+func (u *uploadReader) Read(p []byte) (int, error) {
+	n, err := u.reader.Read(p)
+
+	if n > 0 {
+		u.hash.Write(p[:n])
+		u.read += int64(n)
+
+		if u.maxSize > 0 && u.read > u.maxSize {
+			u.err = ErrUploadTooLarge
+
+			return n, u.err
+		}
+
+		if u.progress != nil {
+			u.progress(u.read, u.total)
+		}
+	}
+
+	if errors.Is(err, io.EOF) {
+		if u.total > 0 && u.read != u.total {
+			u.err = ErrUploadSizeMismatch
+
+			return n, u.err
+		}
+
+		if u.expected != "" && !strings.EqualFold(hex.EncodeToString(u.hash.Sum(nil)), u.expected) {
+			u.err = ErrChecksumMismatch
+
+			return n, u.err
+		}
+	}
+
+	return n, err
+}
+
+// validateUploadDatabaseRequest validates the upload database request
+func validateUploadDatabaseRequest(req UploadDatabaseRequest) error {
+	if err := validateDatabaseName(req.Name); err != nil {
+		return err
+	}
+
+	if req.Path == "" && req.Reader == nil {
+		return newMissingRequiredFieldError("path or reader")
+	}
+
+	if req.Path != "" && req.Reader != nil {
+		return newInvalidFieldError("path", "only one of path or reader can be set")
+	}
+
+	if req.Size < 0 || req.MaxSize < 0 {
+		return newInvalidFieldError("size", "must not be negative")
+	}
+
+	return nil
+}
@@ -0,0 +1,226 @@
+package turso
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// uploadServer records the requests made while uploading a database
+type uploadServer struct {
+	uploaded []byte
+	token    string
+	deleted  bool
+}
+
+func (s *uploadServer) Do(req *http.Request) (*http.Response, error) {
+	body := `{}`
+
+	switch {
+	case req.Method == http.MethodPost && req.URL.Path == "/v1/organizations/meow/databases":
+		body = `{"database":{"DbId":"0eb771dd-6906-11ee-8553-eaa7715aeaf2","Hostname":"my-db-meow.turso.io","Name":"my-db"}}`
+	case req.URL.Path == "/v1/organizations/meow/databases/my-db/auth/tokens":
+		body = `{"jwt":"db-token"}`
+	case req.Method == http.MethodDelete:
+		s.deleted = true
+		body = `{"database":"my-db"}`
+	case req.URL.Host == "my-db-meow.turso.io" && req.URL.Path == "/v1/upload":
+		s.token = req.Header.Get("Authorization")
+
+		uploaded, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		s.uploaded = uploaded
+	}
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(bytes.NewReader([]byte(body))),
+	}, nil
+}
+
+func newUploadClient(t *testing.T) (*Client, *uploadServer) {
+	client, err := NewClient(Config{
+		Token:   "api-token",
+		BaseURL: "http://localhost",
+		OrgName: "meow",
+	})
+	require.NoError(t, err)
+
+	server := &uploadServer{}
+	client.client = server
+
+	return client, server
+}
+
+func sqliteFile() []byte {
+	return append([]byte(sqliteHeader), bytes.Repeat([]byte{0x01}, 4080)...)
+}
+
+func TestUploadDatabase(t *testing.T) {
+	client, server := newUploadClient(t)
+
+	file := sqliteFile()
+	sum := sha256.Sum256(file)
+
+	var progress []int64
+
+	resp, err := client.UploadDatabase(context.Background(), UploadDatabaseRequest{
+		Name:   "my-db",
+		Group:  "default",
+		Reader: bytes.NewReader(file),
+		Size:   int64(len(file)),
+		SHA256: hex.EncodeToString(sum[:]),
+		Progress: func(uploaded, total int64) {
+			assert.Equal(t, int64(len(file)), total)
+
+			progress = append(progress, uploaded)
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "my-db", resp.Database.Name)
+	assert.Equal(t, int64(len(file)), resp.Size)
+	assert.Equal(t, hex.EncodeToString(sum[:]), resp.SHA256)
+	assert.Equal(t, file, server.uploaded)
+	assert.Equal(t, "Bearer db-token", server.token)
+	assert.False(t, server.deleted)
+	require.NotEmpty(t, progress)
+	assert.Equal(t, int64(len(file)), progress[len(progress)-1])
+}
+
+func TestUploadDatabaseFromPath(t *testing.T) {
+	client, server := newUploadClient(t)
+
+	path := filepath.Join(t.TempDir(), "tenant.db")
+	require.NoError(t, os.WriteFile(path, sqliteFile(), 0o600))
+
+	resp, err := client.UploadDatabase(context.Background(), UploadDatabaseRequest{
+		Name: "my-db",
+		Path: path,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(sqliteFile())), resp.Size)
+	assert.Equal(t, sqliteFile(), server.uploaded)
+
+	// test error, file exceeds the max size
+	resp, err = client.UploadDatabase(context.Background(), UploadDatabaseRequest{
+		Name:    "my-db",
+		Path:    path,
+		MaxSize: 1024,
+	})
+	require.ErrorIs(t, err, ErrUploadTooLarge)
+	assert.Nil(t, resp)
+}
+
+func TestUploadDatabaseVerificationErrors(t *testing.T) {
+	// not a sqlite file, the database is never created
+	client, server := newUploadClient(t)
+
+	resp, err := client.UploadDatabase(context.Background(), UploadDatabaseRequest{
+		Name:   "my-db",
+		Reader: strings.NewReader("CREATE TABLE users (id INTEGER);"),
+	})
+	require.ErrorIs(t, err, ErrInvalidSQLiteFile)
+	assert.Nil(t, resp)
+	assert.False(t, server.deleted)
+
+	// checksum mismatch, the database is deleted
+	client, server = newUploadClient(t)
+
+	resp, err = client.UploadDatabase(context.Background(), UploadDatabaseRequest{
+		Name:   "my-db",
+		Reader: bytes.NewReader(sqliteFile()),
+		SHA256: "0000",
+	})
+	require.ErrorIs(t, err, ErrChecksumMismatch)
+	assert.Nil(t, resp)
+	assert.True(t, server.deleted)
+
+	// max size exceeded while streaming an unknown size
+	client, server = newUploadClient(t)
+
+	resp, err = client.UploadDatabase(context.Background(), UploadDatabaseRequest{
+		Name:    "my-db",
+		Reader:  io.MultiReader(bytes.NewReader(sqliteFile())),
+		MaxSize: 1024,
+	})
+	require.ErrorIs(t, err, ErrUploadTooLarge)
+	assert.Nil(t, resp)
+	assert.True(t, server.deleted)
+
+	// size mismatch
+	client, _ = newUploadClient(t)
+
+	resp, err = client.UploadDatabase(context.Background(), UploadDatabaseRequest{
+		Name:   "my-db",
+		Reader: bytes.NewReader(sqliteFile()),
+		Size:   10000,
+	})
+	require.ErrorIs(t, err, ErrUploadSizeMismatch)
+	assert.Nil(t, resp)
+}
+
+func TestValidateUploadDatabaseRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		request UploadDatabaseRequest
+		wantErr error
+	}{
+		{
+			name:    "valid request, reader",
+			request: UploadDatabaseRequest{Name: "my-db", Reader: strings.NewReader("")},
+			wantErr: nil,
+		},
+		{
+			name:    "valid request, path",
+			request: UploadDatabaseRequest{Name: "my-db", Path: "tenant.db"},
+			wantErr: nil,
+		},
+		{
+			name:    "invalid name",
+			request: UploadDatabaseRequest{Name: "My DB", Path: "tenant.db"},
+			wantErr: ErrInvalidDatabaseName,
+		},
+		{
+			name:    "missing file",
+			request: UploadDatabaseRequest{Name: "my-db"},
+			wantErr: &MissingRequiredFieldError{RequiredField: "path or reader"},
+		},
+		{
+			name:    "path and reader",
+			request: UploadDatabaseRequest{Name: "my-db", Path: "tenant.db", Reader: strings.NewReader("")},
+			wantErr: &InvalidFieldError{Field: "path", Message: "only one of path or reader can be set"},
+		},
+		{
+			name:    "negative size",
+			request: UploadDatabaseRequest{Name: "my-db", Path: "tenant.db", MaxSize: -1},
+			wantErr: &InvalidFieldError{Field: "size", Message: "must not be negative"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateUploadDatabaseRequest(tt.request)
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.wantErr.Error())
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package turso
 import (
 	"errors"
 	"fmt"
+	"net/http"
 )
 
 var (
@@ -17,6 +18,40 @@ var (
 
 	// ErrAuthorizationInvalid is returned when the authorization is invalid
 	ErrAuthorizationInvalid = errors.New("authorization invalid, valid options are full-access or read-only")
+
+	// ErrPermissionDenied is returned when the API token is not allowed to perform the request
+	ErrPermissionDenied = errors.New("permission denied")
+
+	// ErrNotFound is returned when the requested resource does not exist
+	ErrNotFound = errors.New("not found")
+
+	// ErrConflict is returned when the request conflicts with the current state of the resource
+	ErrConflict = errors.New("conflict")
+
+	// ErrGroupMismatch is returned when the target organization of a transfer has no group matching the group of the database
+	ErrGroupMismatch = errors.New("group mismatch, the target organization must have a group with the same name")
+
+	// ErrInvalidSQLiteFile is returned when an uploaded file is not a SQLite database
+	ErrInvalidSQLiteFile = errors.New("invalid sqlite file, the file does not start with the sqlite header")
+
+	// ErrUploadTooLarge is returned when an uploaded file exceeds the maximum size
+	ErrUploadTooLarge = errors.New("upload too large, the file exceeds the maximum size")
+
+	// ErrUploadSizeMismatch is returned when an uploaded file does not match the expected size
+	ErrUploadSizeMismatch = errors.New("upload size mismatch, the file does not match the expected size")
+
+	// ErrChecksumMismatch is returned when an uploaded file does not match the expected checksum
+	ErrChecksumMismatch = errors.New("checksum mismatch, the file does not match the expected sha256 checksum")
+
+	// ErrRestoreInProgress is returned when a restore would swap a copy left by an earlier run without its saved state
+	ErrRestoreInProgress = errors.New("restore in progress, the copy already exists, resume with the saved restore state or delete the copy")
+
+	// ErrUpgradeNotStarted is returned when no version changed after a group upgrade was triggered,
+	// usually because the group is already on the latest version
+	ErrUpgradeNotStarted = errors.New("upgrade not started, no version changed after the update was triggered")
+
+	// ErrNoReachableLocation is returned when none of the probed locations could be reached
+	ErrNoReachableLocation = errors.New("no reachable location, all latency probes failed")
 )
 
 // TursoError is returned when a request to the Turso API fails
@@ -34,6 +69,20 @@ func (e *TursoError) Error() string {
 	return fmt.Sprintf("error %s %s: %d", e.Method, e.Object, e.Status)
 }
 
+// Unwrap returns the sentinel error matching the status code so callers can use errors.Is
+func (e *TursoError) Unwrap() error {
+	switch e.Status {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return ErrPermissionDenied
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusConflict:
+		return ErrConflict
+	}
+
+	return nil
+}
+
 // newBadRequestError returns an error a bad request
 func newBadRequestError(object, method string, status int) *TursoError {
 	return &TursoError{
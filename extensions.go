@@ -0,0 +1,71 @@
+package turso
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Extension is a SQLite extension that can be enabled on a group
+type Extension string
+
+const (
+	// ExtensionAll enables all extensions supported by Turso, it is the only value accepted by the API
+	ExtensionAll Extension = "all"
+)
+
+var validExtensions = []Extension{ExtensionAll}
+
+// Extensions is the set of extensions enabled on a group
+// it is sent to and received from the API as a comma separated string, e.g. "all"
+type Extensions []Extension
+
+// Has returns true when the extension is enabled, either directly or by enabling all extensions
+func (e Extensions) Has(ext Extension) bool {
+	return slices.Contains(e, ext) || slices.Contains(e, ExtensionAll)
+}
+
+// String returns the extensions as the comma separated string used by the API
+func (e Extensions) String() string {
+	names := make([]string, len(e))
+	for i, ext := range e {
+		names[i] = string(ext)
+	}
+
+	return strings.Join(names, ",")
+}
+
+// MarshalJSON satisfies the json.Marshaler interface
+func (e Extensions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(e.String())
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface
+func (e *Extensions) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	*e = nil
+
+	for _, ext := range strings.Split(s, ",") {
+		if ext = strings.TrimSpace(ext); ext != "" {
+			*e = append(*e, Extension(ext))
+		}
+	}
+
+	return nil
+}
+
+// validateExtensions validates the extensions are accepted by the API
+func validateExtensions(extensions Extensions) error {
+	for _, ext := range extensions {
+		if !slices.Contains(validExtensions, ext) {
+			return newInvalidFieldError("extensions", fmt.Sprintf("%s is not a known extension", ext))
+		}
+	}
+
+	return nil
+}
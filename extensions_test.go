@@ -0,0 +1,98 @@
+package turso
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtensionsMarshalJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    CreateGroupRequest
+		expected string
+	}{
+		{
+			name:     "no extensions",
+			input:    CreateGroupRequest{Name: "meow", Location: "ams"},
+			expected: `{"location":"ams","name":"meow"}`,
+		},
+		{
+			name:     "all extensions",
+			input:    CreateGroupRequest{Name: "meow", Location: "ams", Extensions: Extensions{ExtensionAll}},
+			expected: `{"extensions":"all","location":"ams","name":"meow"}`,
+		},
+		{
+			name:     "multiple extensions are comma separated",
+			input:    CreateGroupRequest{Name: "meow", Location: "ams", Extensions: Extensions{"a", "b"}},
+			expected: `{"extensions":"a,b","location":"ams","name":"meow"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := json.Marshal(tt.input)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.expected, string(out))
+		})
+	}
+}
+
+func TestExtensionsUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected Extensions
+	}{
+		{
+			name:     "all extensions",
+			input:    `{"extensions":"all"}`,
+			expected: Extensions{ExtensionAll},
+		},
+		{
+			name:     "comma separated extensions",
+			input:    `{"extensions":"a, b"}`,
+			expected: Extensions{"a", "b"},
+		},
+		{
+			name:     "empty string",
+			input:    `{"extensions":""}`,
+			expected: nil,
+		},
+		{
+			name:     "not set",
+			input:    `{}`,
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var group Group
+
+			require.NoError(t, json.Unmarshal([]byte(tt.input), &group))
+			assert.Equal(t, tt.expected, group.Extensions)
+		})
+	}
+}
+
+func TestExtensionsRoundTrip(t *testing.T) {
+	in := Extensions{ExtensionAll}
+
+	out, err := json.Marshal(in)
+	require.NoError(t, err)
+
+	var decoded Extensions
+
+	require.NoError(t, json.Unmarshal(out, &decoded))
+	assert.Equal(t, in, decoded)
+}
+
+func TestExtensionsHas(t *testing.T) {
+	assert.True(t, Extensions{"vector"}.Has("vector"))
+	assert.False(t, Extensions{"vector"}.Has("uuid"))
+	assert.True(t, Extensions{ExtensionAll}.Has("uuid"))
+	assert.False(t, Extensions{}.Has("vector"))
+}
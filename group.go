@@ -9,8 +9,12 @@ import (
 )
 
 const (
-	groupEndpoint    = "v1/organizations/%s/groups"
-	locationEndpoint = groupEndpoint + "%s/locations/%s"
+	groupEndpoint          = "v1/organizations/%s/groups"
+	locationEndpoint       = groupEndpoint + "/%s/locations/%s"
+	groupConfigEndpoint    = groupEndpoint + "/%s/configuration"
+	groupTransferEndpoint  = groupEndpoint + "/%s/transfer"
+	groupUnarchiveEndpoint = groupEndpoint + "/%s/unarchive"
+	groupUpdateEndpoint    = groupEndpoint + "/%s/update"
 )
 
 // GroupService is the interface for the Turso API group endpoint
@@ -29,16 +33,27 @@ type groupService interface {
 	AddLocation(ctx context.Context, eq GroupLocationRequest) (*GroupLocationResponse, error)
 	// RemoveLocation removes a location from a group
 	RemoveLocation(ctx context.Context, req GroupLocationRequest) (*GroupLocationResponse, error)
+	// GetGroupConfig gets the configuration of a group by name
+	GetGroupConfig(ctx context.Context, groupName string) (*GroupConfig, error)
+	// UpdateGroupConfig updates the configuration of a group
+	UpdateGroupConfig(ctx context.Context, req UpdateGroupConfigRequest) (*GroupConfig, error)
+	// TransferGroup transfers a group, and all of its databases, to another organization
+	TransferGroup(ctx context.Context, groupName, targetOrg string) (*Group, error)
+	// UnarchiveGroup unarchives a group that was archived due to inactivity
+	UnarchiveGroup(ctx context.Context, groupName string) (*UnarchiveGroupResponse, error)
+	// UpdateGroupVersion updates all databases in the group to the latest libsql version
+	UpdateGroupVersion(ctx context.Context, groupName string) error
 }
 
 // Group is the struct for the Turso API group service
 type Group struct {
-	Archived  bool     `json:"archived"`
-	Locations []string `json:"locations"`
-	Name      string   `json:"name"`
-	Primary   string   `json:"primary"`
-	UUID      string   `json:"uuid"`
-	Version   string   `json:"version"`
+	Archived   bool       `json:"archived"`
+	Extensions Extensions `json:"extensions,omitempty"`
+	Locations  []string   `json:"locations"`
+	Name       string     `json:"name"`
+	Primary    string     `json:"primary"`
+	UUID       string     `json:"uuid"`
+	Version    string     `json:"version"`
 }
 
 // ListGroupResponse is the struct for the Turso API group list response
@@ -69,6 +84,11 @@ type GroupLocationResponse struct {
 	Group Group `json:"group"`
 }
 
+// UnarchiveGroupResponse is the struct for the Turso API group unarchive response
+type UnarchiveGroupResponse struct {
+	Group Group `json:"group"`
+}
+
 // DeleteGroupResponse is the struct for the Turso API group delete response
 type DeleteGroupResponse struct {
 	Group Group `json:"group"`
@@ -76,9 +96,30 @@ type DeleteGroupResponse struct {
 
 // CreateGroupRequest is the struct for the Turso API group create request
 type CreateGroupRequest struct {
-	Extensions string `json:"extensions"`
-	Location   string `json:"location"`
-	Name       string `json:"name"`
+	Extensions Extensions `json:"extensions,omitempty"`
+	Location   string     `json:"location"`
+	Name       string     `json:"name"`
+}
+
+// GroupConfig is the struct for the Turso API group configuration
+type GroupConfig struct {
+	// DeleteProtection prevents the group from being deleted when enabled
+	DeleteProtection bool `json:"delete_protection"`
+}
+
+// UpdateGroupConfigRequest is the struct for the Turso API group configuration update request
+// only the fields that are set will be updated
+type UpdateGroupConfigRequest struct {
+	// GroupName is the name of the group to update
+	GroupName string `json:"-"`
+	// DeleteProtection prevents the group from being deleted when enabled
+	DeleteProtection *bool `json:"delete_protection,omitempty"`
+}
+
+// TransferGroupRequest is the struct for the Turso API group transfer request
+type TransferGroupRequest struct {
+	// Organization is the name of the organization to transfer the group to
+	Organization string `json:"organization"`
 }
 
 // getGroupEndpoint returns the endpoint for the Turso API group service
@@ -93,6 +134,30 @@ func getGroupLocationsEndpoint(baseURL, orgName, groupName, locationName string)
 	return fmt.Sprintf("%s/%s", baseURL, locEndpoint)
 }
 
+// getGroupConfigEndpoint returns the endpoint for the Turso API group configuration service
+func getGroupConfigEndpoint(baseURL, orgName, groupName string) string {
+	configEndpoint := fmt.Sprintf(groupConfigEndpoint, orgName, groupName)
+	return fmt.Sprintf("%s/%s", baseURL, configEndpoint)
+}
+
+// getGroupTransferEndpoint returns the endpoint for the Turso API group transfer service
+func getGroupTransferEndpoint(baseURL, orgName, groupName string) string {
+	transferEndpoint := fmt.Sprintf(groupTransferEndpoint, orgName, groupName)
+	return fmt.Sprintf("%s/%s", baseURL, transferEndpoint)
+}
+
+// getGroupUnarchiveEndpoint returns the endpoint for the Turso API group unarchive service
+func getGroupUnarchiveEndpoint(baseURL, orgName, groupName string) string {
+	unarchiveEndpoint := fmt.Sprintf(groupUnarchiveEndpoint, orgName, groupName)
+	return fmt.Sprintf("%s/%s", baseURL, unarchiveEndpoint)
+}
+
+// getGroupUpdateEndpoint returns the endpoint for the Turso API group version update service
+func getGroupUpdateEndpoint(baseURL, orgName, groupName string) string {
+	updateEndpoint := fmt.Sprintf(groupUpdateEndpoint, orgName, groupName)
+	return fmt.Sprintf("%s/%s", baseURL, updateEndpoint)
+}
+
 // ListGroups satisfies the groupService interface
 func (s *GroupService) ListGroups(ctx context.Context) (*ListGroupResponse, error) {
 	endpoint := getGroupEndpoint(s.client.cfg.BaseURL, s.client.cfg.OrgName)
@@ -123,6 +188,10 @@ func (s *GroupService) CreateGroup(ctx context.Context, group CreateGroupRequest
 		return nil, err
 	}
 
+	if err := s.client.validateLocationCode(ctx, group.Location); err != nil {
+		return nil, err
+	}
+
 	// Create the group
 	endpoint := getGroupEndpoint(s.client.cfg.BaseURL, s.client.cfg.OrgName)
 
@@ -203,6 +272,10 @@ func (s *GroupService) AddLocation(ctx context.Context, req GroupLocationRequest
 		return nil, err
 	}
 
+	if err := s.client.validateLocationCode(ctx, req.Location); err != nil {
+		return nil, err
+	}
+
 	endpoint := getGroupLocationsEndpoint(s.client.cfg.BaseURL, s.client.cfg.OrgName, req.GroupName, req.Location)
 
 	resp, err := s.client.DoRequest(ctx, http.MethodPost, endpoint, nil)
@@ -253,6 +326,174 @@ func (s *GroupService) RemoveLocation(ctx context.Context, req GroupLocationRequ
 	return &out, nil
 }
 
+// GetGroupConfig satisfies the groupService interface
+func (s *GroupService) GetGroupConfig(ctx context.Context, groupName string) (*GroupConfig, error) {
+	if err := validateGroupName(groupName); err != nil {
+		return nil, err
+	}
+
+	endpoint := getGroupConfigEndpoint(s.client.cfg.BaseURL, s.client.cfg.OrgName, groupName)
+
+	resp, err := s.client.DoRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	var out GroupConfig
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newBadRequestError("group configuration", "getting", resp.StatusCode)
+	}
+
+	return &out, nil
+}
+
+// UpdateGroupConfig satisfies the groupService interface
+func (s *GroupService) UpdateGroupConfig(ctx context.Context, req UpdateGroupConfigRequest) (*GroupConfig, error) {
+	if err := validateGroupName(req.GroupName); err != nil {
+		return nil, err
+	}
+
+	endpoint := getGroupConfigEndpoint(s.client.cfg.BaseURL, s.client.cfg.OrgName, req.GroupName)
+
+	resp, err := s.client.DoRequest(ctx, http.MethodPatch, endpoint, req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	var out GroupConfig
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newBadRequestError("group configuration", "updating", resp.StatusCode)
+	}
+
+	return &out, nil
+}
+
+// TransferGroup satisfies the groupService interface
+// the returned error wraps ErrPermissionDenied or ErrConflict when the transfer is rejected
+func (s *GroupService) TransferGroup(ctx context.Context, groupName, targetOrg string) (*Group, error) {
+	if err := validateTransferRequest(groupName, targetOrg, s.client.cfg.OrgName); err != nil {
+		return nil, err
+	}
+
+	endpoint := getGroupTransferEndpoint(s.client.cfg.BaseURL, s.client.cfg.OrgName, groupName)
+
+	resp, err := s.client.DoRequest(ctx, http.MethodPost, endpoint, TransferGroupRequest{Organization: targetOrg})
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	var out Group
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newBadRequestError("group", "transferring", resp.StatusCode)
+	}
+
+	return &out, nil
+}
+
+// UnarchiveGroup satisfies the groupService interface
+func (s *GroupService) UnarchiveGroup(ctx context.Context, groupName string) (*UnarchiveGroupResponse, error) {
+	if err := validateGroupName(groupName); err != nil {
+		return nil, err
+	}
+
+	endpoint := getGroupUnarchiveEndpoint(s.client.cfg.BaseURL, s.client.cfg.OrgName, groupName)
+
+	resp, err := s.client.DoRequest(ctx, http.MethodPost, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	var out UnarchiveGroupResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newBadRequestError("group", "unarchiving", resp.StatusCode)
+	}
+
+	return &out, nil
+}
+
+// UpdateGroupVersion satisfies the groupService interface
+func (s *GroupService) UpdateGroupVersion(ctx context.Context, groupName string) error {
+	if err := validateGroupName(groupName); err != nil {
+		return err
+	}
+
+	endpoint := getGroupUpdateEndpoint(s.client.cfg.BaseURL, s.client.cfg.OrgName, groupName)
+
+	resp, err := s.client.DoRequest(ctx, http.MethodPost, endpoint, nil)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	// the response has no body, only the status code is checked
+	if resp.StatusCode != http.StatusOK {
+		return newBadRequestError("group version", "updating", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// EnsureGroupActive unarchives the group when it is archived and polls the group
+// until it is no longer archived, or the context is done
+func (c *Client) EnsureGroupActive(ctx context.Context, groupName string) (*Group, error) {
+	out, err := c.Group.GetGroup(ctx, groupName)
+	if err != nil {
+		return nil, err
+	}
+
+	if !out.Group.Archived {
+		return &out.Group, nil
+	}
+
+	if _, err := c.Group.UnarchiveGroup(ctx, groupName); err != nil {
+		return nil, err
+	}
+
+	return c.WaitForGroup(ctx, groupName, WaitOptions{}, GroupNotArchived())
+}
+
+// validateTransferRequest validates the name and target organization of a transfer request
+func validateTransferRequest(name, targetOrg, currentOrg string) error {
+	if name == "" {
+		return newMissingRequiredFieldError("name")
+	}
+
+	if targetOrg == "" {
+		return newMissingRequiredFieldError("organization")
+	}
+
+	if targetOrg == currentOrg {
+		return newInvalidFieldError("organization", "must be different from the current organization")
+	}
+
+	return nil
+}
+
 // validateGroupCreateRequest validates the group create request
 func validateGroupCreateRequest(req CreateGroupRequest) error {
 	if err := validateGroupName(req.Name); err != nil {
@@ -263,6 +504,10 @@ func validateGroupCreateRequest(req CreateGroupRequest) error {
 		return err
 	}
 
+	if err := validateExtensions(req.Extensions); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -0,0 +1,143 @@
+package turso
+
+import (
+	"context"
+	"errors"
+	"slices"
+)
+
+// LocationActionType is the type of change made to the locations of a group
+type LocationActionType string
+
+const (
+	// LocationActionAdd adds a replica location to the group
+	LocationActionAdd LocationActionType = "add"
+	// LocationActionRemove removes a replica location from the group
+	LocationActionRemove LocationActionType = "remove"
+	// LocationActionKeepPrimary keeps the primary location that is not in the desired locations, the primary is never removed
+	LocationActionKeepPrimary LocationActionType = "keep-primary"
+)
+
+// SetGroupLocationsRequest is the struct to reconcile the locations of a group
+type SetGroupLocationsRequest struct {
+	// GroupName is the name of the group
+	GroupName string
+	// Locations are the desired locations of the group
+	Locations []string
+	// DryRun reports the actions without applying them
+	DryRun bool
+}
+
+// LocationAction is a single change made, or planned, to the locations of a group
+type LocationAction struct {
+	// Location is the location code
+	Location string
+	// Action is the type of change
+	Action LocationActionType
+	// Applied is true when the change was made
+	Applied bool
+	// Err is set when the change failed
+	Err error
+}
+
+// SetGroupLocationsResponse is the result of reconciling the locations of a group
+type SetGroupLocationsResponse struct {
+	// Group is the last observed state of the group
+	Group Group
+	// Actions are the changes made, or planned on a dry run, in order
+	Actions []LocationAction
+}
+
+// SetGroupLocations adds the desired locations missing from the group and removes the locations that are not desired,
+// adds are always done before removes and the primary location is never removed
+// failed actions do not stop the remaining actions, the failures are returned joined and the request can be re-run
+func (c *Client) SetGroupLocations(ctx context.Context, req SetGroupLocationsRequest) (*SetGroupLocationsResponse, error) {
+	if err := validateSetGroupLocationsRequest(req); err != nil {
+		return nil, err
+	}
+
+	current, err := c.Group.GetGroup(ctx, req.GroupName)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &SetGroupLocationsResponse{
+		Group:   current.Group,
+		Actions: planLocationActions(current.Group, req.Locations),
+	}
+
+	if req.DryRun {
+		return out, nil
+	}
+
+	var errs []error
+
+	for i, action := range out.Actions {
+		var resp *GroupLocationResponse
+
+		locReq := GroupLocationRequest{GroupName: req.GroupName, Location: action.Location}
+
+		switch action.Action {
+		case LocationActionAdd:
+			resp, err = c.Group.AddLocation(ctx, locReq)
+		case LocationActionRemove:
+			resp, err = c.Group.RemoveLocation(ctx, locReq)
+		default:
+			continue
+		}
+
+		if err != nil {
+			out.Actions[i].Err = err
+			errs = append(errs, err)
+
+			continue
+		}
+
+		out.Actions[i].Applied = true
+		out.Group = resp.Group
+	}
+
+	return out, errors.Join(errs...)
+}
+
+// planLocationActions returns the actions needed to move the group to the desired locations
+func planLocationActions(group Group, desired []string) []LocationAction {
+	actions := []LocationAction{}
+
+	for _, loc := range desired {
+		if !slices.Contains(group.Locations, loc) && !slices.ContainsFunc(actions, func(a LocationAction) bool { return a.Location == loc }) {
+			actions = append(actions, LocationAction{Location: loc, Action: LocationActionAdd})
+		}
+	}
+
+	for _, loc := range group.Locations {
+		if slices.Contains(desired, loc) {
+			continue
+		}
+
+		if loc == group.Primary {
+			actions = append(actions, LocationAction{Location: loc, Action: LocationActionKeepPrimary})
+
+			continue
+		}
+
+		actions = append(actions, LocationAction{Location: loc, Action: LocationActionRemove})
+	}
+
+	return actions
+}
+
+// validateSetGroupLocationsRequest validates the set group locations request
+func validateSetGroupLocationsRequest(req SetGroupLocationsRequest) error {
+	if err := validateGroupName(req.GroupName); err != nil {
+		return err
+	}
+
+	for _, loc := range req.Locations {
+		if err := validateLocation(loc); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package turso
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// locationsGroupService keeps track of the locations of a single group
+type locationsGroupService struct {
+	*MockGroupService
+	group Group
+	fail  map[string]bool
+	calls []string
+}
+
+func (s *locationsGroupService) GetGroup(ctx context.Context, groupName string) (*GetGroupResponse, error) {
+	return &GetGroupResponse{Group: s.group}, nil
+}
+
+func (s *locationsGroupService) AddLocation(ctx context.Context, req GroupLocationRequest) (*GroupLocationResponse, error) {
+	s.calls = append(s.calls, "add "+req.Location)
+
+	if s.fail[req.Location] {
+		return nil, errors.New("failed to add location")
+	}
+
+	s.group.Locations = append(s.group.Locations, req.Location)
+
+	return &GroupLocationResponse{Group: s.group}, nil
+}
+
+func (s *locationsGroupService) RemoveLocation(ctx context.Context, req GroupLocationRequest) (*GroupLocationResponse, error) {
+	s.calls = append(s.calls, "remove "+req.Location)
+
+	s.group.Locations = slices.DeleteFunc(s.group.Locations, func(loc string) bool { return loc == req.Location })
+
+	return &GroupLocationResponse{Group: s.group}, nil
+}
+
+func newLocationsClient(fail map[string]bool) (*Client, *locationsGroupService) {
+	groups := &locationsGroupService{
+		MockGroupService: newMockGroupService().(*MockGroupService),
+		group:            Group{Name: "meow", Primary: "lhr", Locations: []string{"lhr", "ams", "bos"}},
+		fail:             fail,
+	}
+
+	client := NewMockClient()
+	client.Group = groups
+
+	return client, groups
+}
+
+func TestSetGroupLocations(t *testing.T) {
+	client, groups := newLocationsClient(nil)
+
+	resp, err := client.SetGroupLocations(context.Background(), SetGroupLocationsRequest{
+		GroupName: "meow",
+		Locations: []string{"ams", "nrt", "den"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"add nrt", "add den", "remove bos"}, groups.calls)
+	assert.Equal(t, []LocationAction{
+		{Location: "nrt", Action: LocationActionAdd, Applied: true},
+		{Location: "den", Action: LocationActionAdd, Applied: true},
+		{Location: "lhr", Action: LocationActionKeepPrimary},
+		{Location: "bos", Action: LocationActionRemove, Applied: true},
+	}, resp.Actions)
+	assert.ElementsMatch(t, []string{"lhr", "ams", "nrt", "den"}, resp.Group.Locations)
+
+	// re-running is a no-op apart from keeping the primary
+	groups.calls = nil
+
+	resp, err = client.SetGroupLocations(context.Background(), SetGroupLocationsRequest{
+		GroupName: "meow",
+		Locations: []string{"ams", "nrt", "den"},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, groups.calls)
+	assert.Equal(t, []LocationAction{{Location: "lhr", Action: LocationActionKeepPrimary}}, resp.Actions)
+}
+
+func TestSetGroupLocationsDryRun(t *testing.T) {
+	client, groups := newLocationsClient(nil)
+
+	resp, err := client.SetGroupLocations(context.Background(), SetGroupLocationsRequest{
+		GroupName: "meow",
+		Locations: []string{"lhr", "nrt", "nrt"},
+		DryRun:    true,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, groups.calls)
+	assert.Equal(t, []LocationAction{
+		{Location: "nrt", Action: LocationActionAdd},
+		{Location: "ams", Action: LocationActionRemove},
+		{Location: "bos", Action: LocationActionRemove},
+	}, resp.Actions)
+}
+
+func TestSetGroupLocationsPartialFailure(t *testing.T) {
+	client, groups := newLocationsClient(map[string]bool{"nrt": true})
+
+	resp, err := client.SetGroupLocations(context.Background(), SetGroupLocationsRequest{
+		GroupName: "meow",
+		Locations: []string{"lhr", "nrt", "den"},
+	})
+	require.Error(t, err)
+	assert.Equal(t, []string{"add nrt", "add den", "remove ams", "remove bos"}, groups.calls)
+	require.Len(t, resp.Actions, 4)
+	assert.Error(t, resp.Actions[0].Err)
+	assert.False(t, resp.Actions[0].Applied)
+	assert.True(t, resp.Actions[1].Applied)
+	assert.Equal(t, []string{"lhr", "den"}, resp.Group.Locations)
+
+	// test error, invalid request
+	resp, err = client.SetGroupLocations(context.Background(), SetGroupLocationsRequest{
+		GroupName: "meow",
+		Locations: []string{"us"},
+	})
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+}
@@ -6,6 +6,7 @@ import (
 	"io"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -155,6 +156,295 @@ func TestRemoveLocation(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, resp)
 }
+
+func TestGroupLocationEndpoint(t *testing.T) {
+	var paths []string
+
+	client := &Client{
+		cfg: &Config{
+			BaseURL: "http://localhost",
+			OrgName: "meow",
+		},
+		client: MockHTTPRequestDoerFunc(func(req *http.Request) (*http.Response, error) {
+			paths = append(paths, req.Method+" "+req.URL.Path)
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewReader([]byte(`{"group":{"name":"default"}}`))),
+			}, nil
+		}),
+	}
+
+	groupService := GroupService{client: client}
+	req := GroupLocationRequest{
+		GroupName: "default",
+		Location:  "den",
+	}
+
+	_, err := groupService.AddLocation(context.Background(), req)
+	require.NoError(t, err)
+
+	_, err = groupService.RemoveLocation(context.Background(), req)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"POST /v1/organizations/meow/groups/default/locations/den",
+		"DELETE /v1/organizations/meow/groups/default/locations/den",
+	}, paths)
+}
+
+func TestGetGroupConfig(t *testing.T) {
+	body := `{"delete_protection":true}`
+	client := &Client{
+		cfg: &Config{
+			BaseURL: "http://localhost",
+			OrgName: "meow",
+		},
+		client: MockHTTPRequestDoerFunc(func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Equal(t, "/v1/organizations/meow/groups/meow/configuration", req.URL.Path)
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewReader([]byte(body))),
+			}, nil
+		}),
+	}
+
+	// happy path
+	groupService := GroupService{client: client}
+
+	resp, err := groupService.GetGroupConfig(context.Background(), "meow")
+	require.NoError(t, err)
+	assert.True(t, resp.DeleteProtection)
+
+	// test error, missing group name
+	resp, err = groupService.GetGroupConfig(context.Background(), "")
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+}
+
+func TestUpdateGroupConfig(t *testing.T) {
+	client := &Client{
+		cfg: &Config{
+			BaseURL: "http://localhost",
+			OrgName: "meow",
+		},
+		client: MockHTTPRequestDoerFunc(func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, http.MethodPatch, req.Method)
+			assert.Equal(t, "/v1/organizations/meow/groups/meow/configuration", req.URL.Path)
+
+			body, err := io.ReadAll(req.Body)
+			require.NoError(t, err)
+			assert.JSONEq(t, `{"delete_protection":false}`, string(body))
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewReader(body)),
+			}, nil
+		}),
+	}
+
+	// happy path
+	groupService := GroupService{client: client}
+	deleteProtection := false
+
+	resp, err := groupService.UpdateGroupConfig(context.Background(), UpdateGroupConfigRequest{
+		GroupName:        "meow",
+		DeleteProtection: &deleteProtection,
+	})
+	require.NoError(t, err)
+	assert.False(t, resp.DeleteProtection)
+
+	// test error, missing group name
+	resp, err = groupService.UpdateGroupConfig(context.Background(), UpdateGroupConfigRequest{})
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+}
+
+func TestTransferGroup(t *testing.T) {
+	newClient := func(status int, body string) *Client {
+		return &Client{
+			cfg: &Config{
+				BaseURL: "http://localhost",
+				OrgName: "shared",
+			},
+			client: MockHTTPRequestDoerFunc(func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, http.MethodPost, req.Method)
+				assert.Equal(t, "/v1/organizations/shared/groups/meow/transfer", req.URL.Path)
+
+				reqBody, err := io.ReadAll(req.Body)
+				require.NoError(t, err)
+				assert.JSONEq(t, `{"organization":"dedicated"}`, string(reqBody))
+
+				return &http.Response{
+					StatusCode: status,
+					Body:       io.NopCloser(bytes.NewReader([]byte(body))),
+				}, nil
+			}),
+		}
+	}
+
+	// happy path
+	groupService := GroupService{client: newClient(http.StatusOK, `{"name":"meow","primary":"lhr","locations":["lhr"]}`)}
+
+	resp, err := groupService.TransferGroup(context.Background(), "meow", "dedicated")
+	require.NoError(t, err)
+	assert.Equal(t, "meow", resp.Name)
+
+	// test error, permission denied
+	groupService = GroupService{client: newClient(http.StatusForbidden, `{"error":"forbidden"}`)}
+
+	resp, err = groupService.TransferGroup(context.Background(), "meow", "dedicated")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrPermissionDenied)
+	assert.Nil(t, resp)
+
+	// test error, conflict
+	groupService = GroupService{client: newClient(http.StatusConflict, `{"error":"group already exists"}`)}
+
+	resp, err = groupService.TransferGroup(context.Background(), "meow", "dedicated")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrConflict)
+	assert.Nil(t, resp)
+
+	// test error, same organization
+	resp, err = groupService.TransferGroup(context.Background(), "meow", "shared")
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	// test error, missing target organization
+	resp, err = groupService.TransferGroup(context.Background(), "meow", "")
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+}
+
+func TestUnarchiveGroup(t *testing.T) {
+	body := `{"group":{"archived":false,"locations":["lhr"],"name":"meow","primary":"lhr"}}`
+	client := &Client{
+		cfg: &Config{
+			BaseURL: "http://localhost",
+			OrgName: "meow",
+		},
+		client: MockHTTPRequestDoerFunc(func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, http.MethodPost, req.Method)
+			assert.Equal(t, "/v1/organizations/meow/groups/meow/unarchive", req.URL.Path)
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewReader([]byte(body))),
+			}, nil
+		}),
+	}
+
+	// happy path
+	groupService := GroupService{client: client}
+
+	resp, err := groupService.UnarchiveGroup(context.Background(), "meow")
+	require.NoError(t, err)
+	assert.False(t, resp.Group.Archived)
+
+	// test error, missing group name
+	resp, err = groupService.UnarchiveGroup(context.Background(), "")
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+}
+
+func TestUpdateGroupVersion(t *testing.T) {
+	client := &Client{
+		cfg: &Config{
+			BaseURL: "http://localhost",
+			OrgName: "meow",
+		},
+		client: MockHTTPRequestDoerFunc(func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, http.MethodPost, req.Method)
+			assert.Equal(t, "/v1/organizations/meow/groups/meow/update", req.URL.Path)
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       http.NoBody,
+			}, nil
+		}),
+	}
+
+	// happy path
+	groupService := GroupService{client: client}
+
+	err := groupService.UpdateGroupVersion(context.Background(), "meow")
+	require.NoError(t, err)
+
+	// test error, missing group name
+	err = groupService.UpdateGroupVersion(context.Background(), "")
+	assert.Error(t, err)
+}
+
+// archivedGroupService reports the group as archived until it has been unarchived
+// and polled the given number of times
+type archivedGroupService struct {
+	*MockGroupService
+	pollsUntilActive int
+	unarchived       bool
+	gets             int
+}
+
+func (s *archivedGroupService) GetGroup(ctx context.Context, groupName string) (*GetGroupResponse, error) {
+	s.gets++
+
+	out := *s.GetGroupResponse
+	out.Group.Archived = !s.unarchived || s.pollsUntilActive > 0
+
+	if s.unarchived {
+		s.pollsUntilActive--
+	}
+
+	return &out, nil
+}
+
+func (s *archivedGroupService) UnarchiveGroup(ctx context.Context, groupName string) (*UnarchiveGroupResponse, error) {
+	s.unarchived = true
+
+	return s.UnarchiveGroupResponse, nil
+}
+
+func TestEnsureGroupActive(t *testing.T) {
+	// already active
+	client := NewMockClient()
+
+	group, err := client.EnsureGroupActive(context.Background(), "meow")
+	require.NoError(t, err)
+	assert.False(t, group.Archived)
+
+	// archived, becomes active after a couple of polls
+	groups := &archivedGroupService{
+		MockGroupService: newMockGroupService().(*MockGroupService),
+		pollsUntilActive: 2,
+	}
+
+	client = NewMockClient()
+	client.cfg = &Config{PollInterval: time.Millisecond}
+	client.Group = groups
+
+	group, err = client.EnsureGroupActive(context.Background(), "meow")
+	require.NoError(t, err)
+	assert.False(t, group.Archived)
+	assert.True(t, groups.unarchived)
+	assert.Equal(t, 4, groups.gets)
+
+	// never becomes active before the deadline
+	groups = &archivedGroupService{
+		MockGroupService: newMockGroupService().(*MockGroupService),
+		pollsUntilActive: 1000,
+	}
+	client.Group = groups
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	group, err = client.EnsureGroupActive(ctx, "meow")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Nil(t, group)
+}
+
 func TestValidateGroupCreateRequest(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -201,6 +491,33 @@ func TestValidateGroupCreateRequest(t *testing.T) {
 			},
 			wantErr: &InvalidFieldError{Field: "location", Message: "must be 3 characters"},
 		},
+		{
+			name: "valid extensions",
+			request: CreateGroupRequest{
+				Name:       "the-best",
+				Location:   "ams",
+				Extensions: Extensions{ExtensionAll},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "unknown extension",
+			request: CreateGroupRequest{
+				Name:       "the-best",
+				Location:   "ams",
+				Extensions: Extensions{"alll"},
+			},
+			wantErr: &InvalidFieldError{Field: "extensions", Message: "alll is not a known extension"},
+		},
+		{
+			name: "extension not accepted by the api",
+			request: CreateGroupRequest{
+				Name:       "the-best",
+				Location:   "ams",
+				Extensions: Extensions{"vector"},
+			},
+			wantErr: &InvalidFieldError{Field: "extensions", Message: "vector is not a known extension"},
+		},
 	}
 
 	for _, tt := range tests {
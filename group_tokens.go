@@ -0,0 +1,113 @@
+package turso
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const (
+	groupTokensEndpoint       = "v1/organizations/%s/groups/%s/auth/tokens"
+	groupTokensRotateEndpoint = "v1/organizations/%s/groups/%s/auth/rotate"
+)
+
+// GroupTokensService is the interface for the Turso API group tokens service
+type GroupTokensService service
+
+type groupTokensService interface {
+	// CreateGroupToken creates a new token that is valid for every database in the group
+	CreateGroupToken(ctx context.Context, req CreateGroupTokenRequest) (*CreateGroupTokenResponse, error)
+	// InvalidateGroupTokens invalidates all tokens issued for the group
+	InvalidateGroupTokens(ctx context.Context, groupName string) error
+}
+
+// CreateGroupTokenRequest is the struct for the Turso API group token create request
+type CreateGroupTokenRequest struct {
+	// GroupName is the name of the group
+	GroupName string `json:"-"`
+	// Expiration is the expiration time for the token
+	Expiration string `json:"-"`
+	// Authorization is the permissions for the token
+	Authorization string `json:"-"`
+	// AttachPermissions are the databases the token is allowed to attach
+	AttachPermissions Permissions `json:"permissions"`
+}
+
+// CreateGroupTokenResponse is the struct for the Turso API group token create response
+type CreateGroupTokenResponse struct {
+	JWT string `json:"jwt"`
+}
+
+// getGroupTokensEndpoint returns the endpoint for the Turso API group token service
+func getGroupTokensEndpoint(baseURL, orgName, groupName string) string {
+	tokensEndpoint := fmt.Sprintf(groupTokensEndpoint, orgName, groupName)
+	return fmt.Sprintf("%s/%s", baseURL, tokensEndpoint)
+}
+
+// getGroupTokensRotateEndpoint returns the endpoint for the Turso API group token invalidation service
+func getGroupTokensRotateEndpoint(baseURL, orgName, groupName string) string {
+	rotateEndpoint := fmt.Sprintf(groupTokensRotateEndpoint, orgName, groupName)
+	return fmt.Sprintf("%s/%s", baseURL, rotateEndpoint)
+}
+
+// CreateGroupToken satisfies the groupTokensService interface
+func (s *GroupTokensService) CreateGroupToken(ctx context.Context, req CreateGroupTokenRequest) (*CreateGroupTokenResponse, error) {
+	if err := validateGroupTokenRequest(req); err != nil {
+		return nil, err
+	}
+
+	endpoint := getGroupTokensEndpoint(s.client.cfg.BaseURL, s.client.cfg.OrgName, req.GroupName)
+	endpoint = fmt.Sprintf("%s?expiration=%s&authorization=%s", endpoint, req.Expiration, req.Authorization)
+
+	resp, err := s.client.DoRequest(ctx, http.MethodPost, endpoint, req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	var out CreateGroupTokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newBadRequestError("group token", "creating", resp.StatusCode)
+	}
+
+	return &out, nil
+}
+
+// InvalidateGroupTokens satisfies the groupTokensService interface
+func (s *GroupTokensService) InvalidateGroupTokens(ctx context.Context, groupName string) error {
+	if err := validateGroupName(groupName); err != nil {
+		return err
+	}
+
+	endpoint := getGroupTokensRotateEndpoint(s.client.cfg.BaseURL, s.client.cfg.OrgName, groupName)
+
+	resp, err := s.client.DoRequest(ctx, http.MethodPost, endpoint, nil)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	// the response has no body, only the status code is checked
+	if resp.StatusCode != http.StatusOK {
+		return newBadRequestError("group tokens", "invalidating", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// validateGroupTokenRequest ensures the group name, authorization and expiration are valid
+// in the given request before making the API call
+func validateGroupTokenRequest(req CreateGroupTokenRequest) error {
+	if err := validateGroupName(req.GroupName); err != nil {
+		return err
+	}
+
+	return validateTokenOptions(req.Expiration, req.Authorization)
+}
@@ -0,0 +1,101 @@
+package turso
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateGroupToken(t *testing.T) {
+	body := `{"jwt": "areallylongstringjwtgoeshere"}`
+	client := &Client{
+		cfg: &Config{
+			BaseURL: "http://localhost",
+			OrgName: "meow",
+		},
+		client: MockHTTPRequestDoerFunc(func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, http.MethodPost, req.Method)
+			assert.Equal(t, "/v1/organizations/meow/groups/meow/auth/tokens", req.URL.Path)
+			assert.Equal(t, "2w", req.URL.Query().Get("expiration"))
+			assert.Equal(t, ReadOnly, req.URL.Query().Get("authorization"))
+
+			reqBody, err := io.ReadAll(req.Body)
+			require.NoError(t, err)
+			assert.JSONEq(t, `{"permissions":{"read_attach":{"database":["other-db"]}}}`, string(reqBody))
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewReader([]byte(body))),
+			}, nil
+		}),
+	}
+
+	// happy path
+	groupTokenService := GroupTokensService{client: client}
+	req := CreateGroupTokenRequest{
+		GroupName:     "meow",
+		Expiration:    "2w",
+		Authorization: ReadOnly,
+	}
+	req.AttachPermissions.ReadAttach.Database = []string{"other-db"}
+
+	resp, err := groupTokenService.CreateGroupToken(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, "areallylongstringjwtgoeshere", resp.JWT)
+
+	// test errors
+	resp, err = groupTokenService.CreateGroupToken(context.Background(), CreateGroupTokenRequest{
+		Expiration:    "2w",
+		Authorization: ReadOnly,
+	})
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	resp, err = groupTokenService.CreateGroupToken(context.Background(), CreateGroupTokenRequest{
+		GroupName:     "meow",
+		Authorization: ReadOnly,
+	})
+	assert.ErrorIs(t, err, ErrExpirationInvalid)
+	assert.Nil(t, resp)
+
+	resp, err = groupTokenService.CreateGroupToken(context.Background(), CreateGroupTokenRequest{
+		GroupName:     "meow",
+		Expiration:    "never",
+		Authorization: "invalid",
+	})
+	assert.ErrorIs(t, err, ErrAuthorizationInvalid)
+	assert.Nil(t, resp)
+}
+
+func TestInvalidateGroupTokens(t *testing.T) {
+	client := &Client{
+		cfg: &Config{
+			BaseURL: "http://localhost",
+			OrgName: "meow",
+		},
+		client: MockHTTPRequestDoerFunc(func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, http.MethodPost, req.Method)
+			assert.Equal(t, "/v1/organizations/meow/groups/meow/auth/rotate", req.URL.Path)
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       http.NoBody,
+			}, nil
+		}),
+	}
+
+	// happy path
+	groupTokenService := GroupTokensService{client: client}
+
+	err := groupTokenService.InvalidateGroupTokens(context.Background(), "meow")
+	require.NoError(t, err)
+
+	// test error, missing group name
+	err = groupTokenService.InvalidateGroupTokens(context.Background(), "")
+	assert.Error(t, err)
+}
@@ -0,0 +1,182 @@
+package turso
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"time"
+)
+
+const defaultMaxIdlePolls = 30
+
+// GroupUpgradeReport is the result of upgrading the libsql version of a group
+type GroupUpgradeReport struct {
+	// Group is the name of the upgraded group
+	Group string
+	// FromVersion is the version of the group before the upgrade
+	FromVersion string
+	// ToVersion is the version of the group after the upgrade
+	ToVersion string
+	// Databases are the databases in the group and their upgrade status
+	Databases []DatabaseUpgrade
+	// Complete is true when the group version changed and every database in the group reports the new version
+	Complete bool
+}
+
+// DatabaseUpgrade is the upgrade status of a single database in a group
+type DatabaseUpgrade struct {
+	// Name is the name of the database
+	Name string
+	// FromVersion is the version of the database before the upgrade
+	FromVersion string
+	// ToVersion is the last observed version of the database
+	ToVersion string
+	// Upgraded is true when the version of the database changed
+	Upgraded bool
+}
+
+// UpgradeGroupRequest is the struct to upgrade the libsql version of a group
+type UpgradeGroupRequest struct {
+	// GroupName is the name of the group to upgrade
+	GroupName string
+	// TargetVersion is the version the group is expected to reach, when set the upgrade is complete once the group
+	// and its databases report it and a group already on it is not updated; any new version is accepted when empty
+	TargetVersion string
+	// MaxIdlePolls is the number of polls without any version change after which the upgrade is assumed not to
+	// be needed, e.g. because the group is already on the latest version, defaults to 30
+	MaxIdlePolls int
+}
+
+// UpgradeGroup triggers a libsql version update of the group and polls the group and its databases
+// until the group reports a new version, or the target version, and every database reports it
+// ErrUpgradeNotStarted is returned when no version changed within the max idle polls and the context error
+// when the context is done; the report is returned with the last observed versions in all cases
+func (c *Client) UpgradeGroup(ctx context.Context, req UpgradeGroupRequest) (*GroupUpgradeReport, error) {
+	maxIdlePolls := req.MaxIdlePolls
+	if maxIdlePolls <= 0 {
+		maxIdlePolls = defaultMaxIdlePolls
+	}
+
+	before, err := c.Group.GetGroup(ctx, req.GroupName)
+	if err != nil {
+		return nil, err
+	}
+
+	databases, err := c.groupDatabaseVersions(ctx, req.GroupName)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &GroupUpgradeReport{
+		Group:       req.GroupName,
+		FromVersion: before.Group.Version,
+		ToVersion:   before.Group.Version,
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(databases)) {
+		report.Databases = append(report.Databases, DatabaseUpgrade{
+			Name:        name,
+			FromVersion: databases[name],
+			ToVersion:   databases[name],
+		})
+	}
+
+	// nothing to do when the group is already on the target version
+	if req.TargetVersion != "" && report.update(before.Group.Version, databases, req.TargetVersion) {
+		return report, nil
+	}
+
+	if err := c.Group.UpdateGroupVersion(ctx, req.GroupName); err != nil {
+		return nil, err
+	}
+
+	ticker := time.NewTicker(c.pollInterval())
+	defer ticker.Stop()
+
+	for idle := 0; ; {
+		select {
+		case <-ctx.Done():
+			return report, ctx.Err()
+		case <-ticker.C:
+		}
+
+		group, err := c.Group.GetGroup(ctx, req.GroupName)
+		if err != nil {
+			return report, err
+		}
+
+		databases, err := c.groupDatabaseVersions(ctx, req.GroupName)
+		if err != nil {
+			return report, err
+		}
+
+		target := req.TargetVersion
+		if target == "" && group.Group.Version != report.FromVersion {
+			// until the group reports a new version the databases still match the old one
+			target = group.Group.Version
+		}
+
+		if report.update(group.Group.Version, databases, target) {
+			return report, nil
+		}
+
+		if !report.changed() {
+			idle++
+		}
+
+		if idle >= maxIdlePolls {
+			return report, ErrUpgradeNotStarted
+		}
+	}
+}
+
+// update records the observed versions and reports whether the group and every database are on the target
+// version, it is never complete without a target
+func (r *GroupUpgradeReport) update(groupVersion string, databases map[string]string, target string) bool {
+	r.ToVersion = groupVersion
+	r.Complete = target != "" && groupVersion == target
+
+	for i, db := range r.Databases {
+		version, ok := databases[db.Name]
+		if !ok {
+			// the database was deleted while upgrading
+			continue
+		}
+
+		r.Databases[i].ToVersion = version
+		r.Databases[i].Upgraded = version != db.FromVersion
+
+		if version != target {
+			r.Complete = false
+		}
+	}
+
+	return r.Complete
+}
+
+// changed reports whether the group or any database changed version since the upgrade started
+func (r *GroupUpgradeReport) changed() bool {
+	if r.ToVersion != r.FromVersion {
+		return true
+	}
+
+	return slices.ContainsFunc(r.Databases, func(db DatabaseUpgrade) bool { return db.Upgraded })
+}
+
+// groupDatabaseVersions returns the libsql version of each database in the group keyed by database name
+func (c *Client) groupDatabaseVersions(ctx context.Context, groupName string) (map[string]string, error) {
+	out, err := c.Database.ListDatabases(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := map[string]string{}
+
+	for _, db := range out.Databases {
+		if db.Group == groupName {
+			versions[db.Name] = db.Version
+		}
+	}
+
+	return versions, nil
+}
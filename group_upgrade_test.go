@@ -0,0 +1,167 @@
+package turso
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// upgradingGroupService reports the new version once the update was triggered and the group was
+// polled delay more times
+type upgradingGroupService struct {
+	*MockGroupService
+	triggered bool
+	delay     int
+	updated   bool
+}
+
+func (s *upgradingGroupService) GetGroup(ctx context.Context, groupName string) (*GetGroupResponse, error) {
+	if s.triggered && !s.updated {
+		s.updated = s.delay == 0
+		s.delay--
+	}
+
+	out := *s.GetGroupResponse
+	if s.updated {
+		out.Group.Version = "v0.24.0"
+	}
+
+	return &out, nil
+}
+
+func (s *upgradingGroupService) UpdateGroupVersion(ctx context.Context, groupName string) error {
+	s.triggered = true
+
+	return nil
+}
+
+// upgradingDatabaseService upgrades one database per poll once the group was updated
+type upgradingDatabaseService struct {
+	*MockDatabaseService
+	groups    *upgradingGroupService
+	databases []*Database
+}
+
+func (s *upgradingDatabaseService) ListDatabases(ctx context.Context) (*ListDatabaseResponse, error) {
+	out := &ListDatabaseResponse{}
+
+	upgradedOne := false
+
+	for _, db := range s.databases {
+		copied := *db
+
+		if s.groups.updated && !upgradedOne && db.Group == "meow" && db.Version != "v0.24.0" {
+			db.Version = "v0.24.0"
+			upgradedOne = true
+		}
+
+		out.Databases = append(out.Databases, &copied)
+	}
+
+	return out, nil
+}
+
+func newUpgradeClient(databases []*Database, delay int) *Client {
+	groups := &upgradingGroupService{MockGroupService: newMockGroupService().(*MockGroupService), delay: delay}
+
+	client := NewMockClient()
+	client.cfg = &Config{PollInterval: time.Millisecond}
+	client.Group = groups
+	client.Database = &upgradingDatabaseService{
+		MockDatabaseService: newMockDatabaseService().(*MockDatabaseService),
+		groups:              groups,
+		databases:           databases,
+	}
+
+	return client
+}
+
+func TestUpgradeGroup(t *testing.T) {
+	client := newUpgradeClient([]*Database{
+		{Name: "db-b", Group: "meow", Version: "v0.23.7"},
+		{Name: "db-a", Group: "meow", Version: "v0.23.7"},
+		{Name: "other", Group: "woof", Version: "v0.23.7"},
+	}, 0)
+
+	report, err := client.UpgradeGroup(context.Background(), UpgradeGroupRequest{GroupName: "meow"})
+	require.NoError(t, err)
+	assert.True(t, report.Complete)
+	assert.Equal(t, "v0.23.7", report.FromVersion)
+	assert.Equal(t, "v0.24.0", report.ToVersion)
+	assert.Equal(t, []DatabaseUpgrade{
+		{Name: "db-a", FromVersion: "v0.23.7", ToVersion: "v0.24.0", Upgraded: true},
+		{Name: "db-b", FromVersion: "v0.23.7", ToVersion: "v0.24.0", Upgraded: true},
+	}, report.Databases)
+}
+
+func TestUpgradeGroupDelayed(t *testing.T) {
+	// the group reports the new version a few polls after the update was triggered,
+	// before that every database matches the old group version
+	client := newUpgradeClient([]*Database{
+		{Name: "db-a", Group: "meow", Version: "v0.23.7"},
+	}, 3)
+
+	report, err := client.UpgradeGroup(context.Background(), UpgradeGroupRequest{GroupName: "meow"})
+	require.NoError(t, err)
+	assert.True(t, report.Complete)
+	assert.Equal(t, "v0.24.0", report.ToVersion)
+	assert.Equal(t, []DatabaseUpgrade{
+		{Name: "db-a", FromVersion: "v0.23.7", ToVersion: "v0.24.0", Upgraded: true},
+	}, report.Databases)
+}
+
+func TestUpgradeGroupTimeout(t *testing.T) {
+	client := newUpgradeClient([]*Database{
+		{Name: "db-a", Group: "meow", Version: "v0.23.7"},
+	}, 0)
+
+	// the database never upgrades because the update is never triggered
+	client.Database.(*upgradingDatabaseService).groups = &upgradingGroupService{}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	report, err := client.UpgradeGroup(ctx, UpgradeGroupRequest{GroupName: "meow"})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.NotNil(t, report)
+	assert.False(t, report.Complete)
+	assert.Equal(t, []DatabaseUpgrade{
+		{Name: "db-a", FromVersion: "v0.23.7", ToVersion: "v0.23.7", Upgraded: false},
+	}, report.Databases)
+}
+
+func TestUpgradeGroupAlreadyLatest(t *testing.T) {
+	// the update is accepted but no version ever changes
+	client := newUpgradeClient([]*Database{
+		{Name: "db-a", Group: "meow", Version: "v0.23.7"},
+	}, 1000)
+
+	report, err := client.UpgradeGroup(context.Background(), UpgradeGroupRequest{GroupName: "meow", MaxIdlePolls: 5})
+	require.ErrorIs(t, err, ErrUpgradeNotStarted)
+	assert.False(t, report.Complete)
+	assert.Equal(t, report.FromVersion, report.ToVersion)
+}
+
+func TestUpgradeGroupTargetVersion(t *testing.T) {
+	// already on the target version, the update is not triggered
+	client := newUpgradeClient([]*Database{
+		{Name: "db-a", Group: "meow", Version: "v0.23.7"},
+	}, 0)
+
+	report, err := client.UpgradeGroup(context.Background(), UpgradeGroupRequest{GroupName: "meow", TargetVersion: "v0.23.7"})
+	require.NoError(t, err)
+	assert.True(t, report.Complete)
+	assert.False(t, client.Group.(*upgradingGroupService).triggered)
+
+	// upgraded to the target version
+	report, err = client.UpgradeGroup(context.Background(), UpgradeGroupRequest{GroupName: "meow", TargetVersion: "v0.24.0"})
+	require.NoError(t, err)
+	assert.True(t, report.Complete)
+	assert.Equal(t, "v0.24.0", report.ToVersion)
+	assert.Equal(t, []DatabaseUpgrade{
+		{Name: "db-a", FromVersion: "v0.23.7", ToVersion: "v0.24.0", Upgraded: true},
+	}, report.Databases)
+}
@@ -0,0 +1,216 @@
+package turso
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+const defaultProbeSamples = 3
+
+// LatencyProber measures the round trip latency from the current host to a location
+type LatencyProber interface {
+	// Probe returns the round trip latency to the location
+	Probe(ctx context.Context, location string) (time.Duration, error)
+}
+
+// HTTPLatencyProber measures latency by timing an HTTP request to a per location URL
+type HTTPLatencyProber struct {
+	// Client is the http client used to make the requests, defaults to http.DefaultClient
+	Client HTTPRequestDoer
+	// URLFormat is the format of the URL to probe, the location code is substituted for %s
+	// e.g. https://%s.example.com/health
+	URLFormat string
+}
+
+// Probe satisfies the LatencyProber interface
+func (p *HTTPLatencyProber) Probe(ctx context.Context, location string) (time.Duration, error) {
+	if p.URLFormat == "" {
+		return 0, newMissingRequiredFieldError("url format")
+	}
+
+	client := p.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, fmt.Sprintf(p.URLFormat, location), nil)
+	if err != nil {
+		return 0, err
+	}
+
+	start := time.Now()
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+
+	latency := time.Since(start)
+
+	resp.Body.Close()
+
+	return latency, nil
+}
+
+// RankLocationsRequest is the struct for ranking locations by latency
+type RankLocationsRequest struct {
+	// Catalog is the location catalog, as returned by ListLocations
+	Catalog *ListLocationsResponse
+	// Candidates are the location codes to probe, all locations in the catalog are probed when empty
+	Candidates []string
+	// Prober is used to measure the latency to each location
+	Prober LatencyProber
+	// Samples is the number of probes per location, the fastest is used, defaults to 3
+	Samples int
+}
+
+// LocationLatency is the measured latency to a location
+type LocationLatency struct {
+	// Location is the location that was probed
+	Location Location
+	// Latency is the fastest round trip latency measured
+	Latency time.Duration
+	// Err is set when every probe to the location failed
+	Err error
+}
+
+// RankedLocations is a list of locations ordered from lowest to highest latency
+// unreachable locations are ordered last
+type RankedLocations []LocationLatency
+
+// Best returns the location code with the lowest latency, to be used as the primary location of a group
+func (r RankedLocations) Best() (string, error) {
+	for _, l := range r {
+		if l.Err == nil {
+			return l.Location.Code, nil
+		}
+	}
+
+	return "", ErrNoReachableLocation
+}
+
+// Replicas returns up to n reachable location codes, excluding the best location,
+// to be used when adding locations to a group
+func (r RankedLocations) Replicas(n int) []string {
+	out := []string{}
+
+	for i, l := range r {
+		if len(out) >= n {
+			break
+		}
+
+		if l.Err != nil {
+			break
+		}
+
+		if i == 0 {
+			continue
+		}
+
+		out = append(out, l.Location.Code)
+	}
+
+	return out
+}
+
+// RankLocations probes the candidate locations concurrently and returns them ranked by latency
+func RankLocations(ctx context.Context, req RankLocationsRequest) (RankedLocations, error) {
+	if err := validateRankLocationsRequest(req); err != nil {
+		return nil, err
+	}
+
+	candidates := req.Candidates
+	if len(candidates) == 0 {
+		for _, l := range req.Catalog.Sorted() {
+			candidates = append(candidates, l.Code)
+		}
+	}
+
+	samples := req.Samples
+	if samples < 1 {
+		samples = defaultProbeSamples
+	}
+
+	out := make(RankedLocations, len(candidates))
+
+	var wg sync.WaitGroup
+
+	for i, code := range candidates {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			out[i] = probeLocation(ctx, req.Prober, Location{Code: code, Name: req.Catalog.Locations[code]}, samples)
+		}()
+	}
+
+	wg.Wait()
+
+	sort.SliceStable(out, func(i, j int) bool {
+		if (out[i].Err == nil) != (out[j].Err == nil) {
+			return out[i].Err == nil
+		}
+
+		if out[i].Err != nil {
+			return out[i].Location.Code < out[j].Location.Code
+		}
+
+		return out[i].Latency < out[j].Latency
+	})
+
+	return out, nil
+}
+
+// probeLocation probes a single location and keeps the fastest successful sample
+func probeLocation(ctx context.Context, prober LatencyProber, location Location, samples int) LocationLatency {
+	out := LocationLatency{Location: location}
+
+	var lastErr error
+
+	succeeded := false
+
+	for range samples {
+		latency, err := prober.Probe(ctx, location.Code)
+		if err != nil {
+			lastErr = err
+
+			continue
+		}
+
+		if !succeeded || latency < out.Latency {
+			out.Latency = latency
+		}
+
+		succeeded = true
+	}
+
+	if !succeeded {
+		out.Err = lastErr
+	}
+
+	return out
+}
+
+// validateRankLocationsRequest validates the rank locations request
+func validateRankLocationsRequest(req RankLocationsRequest) error {
+	if req.Catalog == nil {
+		return newMissingRequiredFieldError("catalog")
+	}
+
+	if req.Prober == nil {
+		return newMissingRequiredFieldError("prober")
+	}
+
+	for _, code := range req.Candidates {
+		if _, ok := req.Catalog.Locations[code]; !ok {
+			return newInvalidFieldError("candidates", fmt.Sprintf("%s is not a known location", code))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,175 @@
+package turso
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockLatencyProber returns a fixed latency per location, locations without a latency fail
+type mockLatencyProber struct {
+	mu        sync.Mutex
+	latencies map[string]time.Duration
+	calls     map[string]int
+}
+
+func (p *mockLatencyProber) Probe(ctx context.Context, location string) (time.Duration, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.calls == nil {
+		p.calls = map[string]int{}
+	}
+
+	p.calls[location]++
+
+	latency, ok := p.latencies[location]
+	if !ok {
+		return 0, errors.New("unreachable")
+	}
+
+	// make the first sample slower to ensure the fastest sample is kept
+	if p.calls[location] == 1 {
+		return latency * 2, nil
+	}
+
+	return latency, nil
+}
+
+func TestRankLocations(t *testing.T) {
+	catalog := &ListLocationsResponse{
+		Locations: map[string]string{
+			"ams": "Amsterdam, Netherlands",
+			"bos": "Boston, Massachusetts (US)",
+			"lhr": "London, United Kingdom",
+			"nrt": "Tokyo, Japan",
+		},
+	}
+
+	prober := &mockLatencyProber{
+		latencies: map[string]time.Duration{
+			"ams": 20 * time.Millisecond,
+			"bos": 80 * time.Millisecond,
+			"lhr": 10 * time.Millisecond,
+		},
+	}
+
+	ranked, err := RankLocations(context.Background(), RankLocationsRequest{
+		Catalog: catalog,
+		Prober:  prober,
+	})
+	require.NoError(t, err)
+	require.Len(t, ranked, 4)
+
+	assert.Equal(t, Location{Code: "lhr", Name: "London, United Kingdom"}, ranked[0].Location)
+	assert.Equal(t, 10*time.Millisecond, ranked[0].Latency)
+	assert.Equal(t, "ams", ranked[1].Location.Code)
+	assert.Equal(t, "bos", ranked[2].Location.Code)
+	assert.Equal(t, "nrt", ranked[3].Location.Code)
+	assert.Error(t, ranked[3].Err)
+	assert.Equal(t, defaultProbeSamples, prober.calls["lhr"])
+
+	best, err := ranked.Best()
+	require.NoError(t, err)
+	assert.Equal(t, "lhr", best)
+
+	assert.Equal(t, []string{"ams"}, ranked.Replicas(1))
+	assert.Equal(t, []string{"ams", "bos"}, ranked.Replicas(5))
+
+	// only probe the candidates
+	ranked, err = RankLocations(context.Background(), RankLocationsRequest{
+		Catalog:    catalog,
+		Candidates: []string{"bos", "ams"},
+		Prober:     prober,
+		Samples:    1,
+	})
+	require.NoError(t, err)
+	require.Len(t, ranked, 2)
+	assert.Equal(t, "ams", ranked[0].Location.Code)
+
+	// no reachable locations
+	ranked, err = RankLocations(context.Background(), RankLocationsRequest{
+		Catalog:    catalog,
+		Candidates: []string{"nrt"},
+		Prober:     prober,
+	})
+	require.NoError(t, err)
+
+	_, err = ranked.Best()
+	assert.ErrorIs(t, err, ErrNoReachableLocation)
+	assert.Empty(t, ranked.Replicas(1))
+}
+
+func TestValidateRankLocationsRequest(t *testing.T) {
+	catalog := &ListLocationsResponse{
+		Locations: map[string]string{"ams": "Amsterdam, Netherlands"},
+	}
+
+	tests := []struct {
+		name    string
+		request RankLocationsRequest
+		wantErr error
+	}{
+		{
+			name:    "valid request",
+			request: RankLocationsRequest{Catalog: catalog, Prober: &mockLatencyProber{}, Candidates: []string{"ams"}},
+			wantErr: nil,
+		},
+		{
+			name:    "missing catalog",
+			request: RankLocationsRequest{Prober: &mockLatencyProber{}},
+			wantErr: &MissingRequiredFieldError{RequiredField: "catalog"},
+		},
+		{
+			name:    "missing prober",
+			request: RankLocationsRequest{Catalog: catalog},
+			wantErr: &MissingRequiredFieldError{RequiredField: "prober"},
+		},
+		{
+			name:    "unknown candidate",
+			request: RankLocationsRequest{Catalog: catalog, Prober: &mockLatencyProber{}, Candidates: []string{"xyz"}},
+			wantErr: &InvalidFieldError{Field: "candidates", Message: "xyz is not a known location"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateRankLocationsRequest(tt.request)
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.wantErr.Error())
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestHTTPLatencyProber(t *testing.T) {
+	var requested string
+
+	prober := &HTTPLatencyProber{
+		Client: MockHTTPRequestDoerFunc(func(req *http.Request) (*http.Response, error) {
+			requested = req.URL.String()
+
+			return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+		}),
+		URLFormat: "https://%s.example.com/health",
+	}
+
+	_, err := prober.Probe(context.Background(), "ams")
+	require.NoError(t, err)
+	assert.Equal(t, "https://ams.example.com/health", requested)
+
+	// test error, missing url format
+	_, err = (&HTTPLatencyProber{}).Probe(context.Background(), "ams")
+	assert.Error(t, err)
+}
@@ -0,0 +1,149 @@
+package turso
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+)
+
+const (
+	locationsEndpoint  = "v1/locations"
+	closestLocationURL = "https://region.turso.io"
+)
+
+// LocationService is the interface for the Turso API locations endpoint
+type LocationService service
+
+type locationService interface {
+	// ListLocations lists all locations available to create groups and databases in
+	ListLocations(ctx context.Context) (*ListLocationsResponse, error)
+	// ClosestLocation returns the location closest to the caller
+	ClosestLocation(ctx context.Context) (*ClosestLocationResponse, error)
+}
+
+// Location is the struct for a Turso location
+type Location struct {
+	// Code is the three character location code, e.g. ams
+	Code string
+	// Name is the human readable name of the location, e.g. Amsterdam, Netherlands
+	Name string
+}
+
+// ListLocationsResponse is the struct for the Turso API locations list response
+type ListLocationsResponse struct {
+	// Locations is a map of location codes to human readable names
+	Locations map[string]string `json:"locations"`
+}
+
+// ClosestLocationResponse is the struct for the Turso API closest location response
+type ClosestLocationResponse struct {
+	// Server is the location code of the closest location
+	Server string `json:"server"`
+	// Client is the location code the client request was received in
+	Client string `json:"client"`
+}
+
+// Sorted returns the locations sorted by code
+func (r *ListLocationsResponse) Sorted() []Location {
+	out := make([]Location, 0, len(r.Locations))
+
+	for code, name := range r.Locations {
+		out = append(out, Location{Code: code, Name: name})
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Code < out[j].Code
+	})
+
+	return out
+}
+
+// getLocationsEndpoint returns the endpoint for the Turso API locations service
+func getLocationsEndpoint(baseURL string) string {
+	return fmt.Sprintf("%s/%s", baseURL, locationsEndpoint)
+}
+
+// ListLocations satisfies the locationService interface
+func (s *LocationService) ListLocations(ctx context.Context) (*ListLocationsResponse, error) {
+	endpoint := getLocationsEndpoint(s.client.cfg.BaseURL)
+
+	resp, err := s.client.DoRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	var out ListLocationsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newBadRequestError("locations", "listing", resp.StatusCode)
+	}
+
+	return &out, nil
+}
+
+// ClosestLocation satisfies the locationService interface
+func (s *LocationService) ClosestLocation(ctx context.Context) (*ClosestLocationResponse, error) {
+	// the closest region endpoint is not part of the platform API and does not need the API token
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, closestLocationURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	var out ClosestLocationResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newBadRequestError("closest location", "getting", resp.StatusCode)
+	}
+
+	return &out, nil
+}
+
+// locationCatalog caches the locations returned by the Turso API
+type locationCatalog struct {
+	mu    sync.Mutex
+	codes map[string]string
+}
+
+// validateLocationCode checks the location against the cached location catalog
+// when location validation is enabled in the config, the catalog is loaded on first use
+func (c *Client) validateLocationCode(ctx context.Context, location string) error {
+	if c.cfg == nil || !c.cfg.ValidateLocations {
+		return nil
+	}
+
+	c.locations.mu.Lock()
+	defer c.locations.mu.Unlock()
+
+	if c.locations.codes == nil {
+		out, err := c.Locations.ListLocations(ctx)
+		if err != nil {
+			return err
+		}
+
+		c.locations.codes = out.Locations
+	}
+
+	if _, ok := c.locations.codes[location]; !ok {
+		return newInvalidFieldError("location", fmt.Sprintf("%s is not a known location", location))
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package turso
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListLocations(t *testing.T) {
+	body := `{"locations":{"ams":"Amsterdam, Netherlands","lhr":"London, United Kingdom","bos":"Boston, Massachusetts (US)"}}`
+	client := &Client{
+		cfg: &Config{
+			BaseURL: "http://localhost",
+		},
+		client: &MockHTTPRequestDoer{
+			Response: &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewReader([]byte(body))),
+			},
+		},
+	}
+
+	locationService := LocationService{client: client}
+
+	resp, err := locationService.ListLocations(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, resp.Locations, 3)
+	assert.Equal(t, "Amsterdam, Netherlands", resp.Locations["ams"])
+
+	sorted := resp.Sorted()
+	require.Len(t, sorted, 3)
+	assert.Equal(t, Location{Code: "ams", Name: "Amsterdam, Netherlands"}, sorted[0])
+	assert.Equal(t, "lhr", sorted[2].Code)
+}
+
+func TestClosestLocation(t *testing.T) {
+	body := `{"server":"lhr","client":"lhr"}`
+	client := &Client{
+		cfg: &Config{
+			Token:   "secret",
+			BaseURL: "http://localhost",
+		},
+		client: MockHTTPRequestDoerFunc(func(req *http.Request) (*http.Response, error) {
+			// the API token should never be sent outside of the platform API
+			assert.Empty(t, req.Header.Get("Authorization"))
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewReader([]byte(body))),
+			}, nil
+		}),
+	}
+
+	locationService := LocationService{client: client}
+
+	resp, err := locationService.ClosestLocation(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "lhr", resp.Server)
+}
+
+func TestValidateLocationCode(t *testing.T) {
+	catalogRequests := 0
+
+	client := &Client{
+		cfg: &Config{
+			BaseURL:           "http://localhost",
+			OrgName:           "meow",
+			ValidateLocations: true,
+		},
+		client: MockHTTPRequestDoerFunc(func(req *http.Request) (*http.Response, error) {
+			body := `{"group":{"locations":["ams"],"name":"meow","primary":"ams"}}`
+
+			if strings.HasSuffix(req.URL.Path, "/v1/locations") {
+				catalogRequests++
+
+				body = `{"locations":{"ams":"Amsterdam, Netherlands","lhr":"London, United Kingdom"}}`
+			}
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewReader([]byte(body))),
+			}, nil
+		}),
+	}
+	client.common.client = client
+	client.Locations = (*LocationService)(&client.common)
+
+	groupService := GroupService{client: client}
+
+	// happy path
+	resp, err := groupService.CreateGroup(context.Background(), CreateGroupRequest{Name: "meow", Location: "ams"})
+	require.NoError(t, err)
+	assert.Equal(t, "meow", resp.Group.Name)
+
+	loc, err := groupService.AddLocation(context.Background(), GroupLocationRequest{GroupName: "meow", Location: "lhr"})
+	require.NoError(t, err)
+	assert.NotNil(t, loc)
+
+	// test error, unknown location
+	resp, err = groupService.CreateGroup(context.Background(), CreateGroupRequest{Name: "meow", Location: "xyz"})
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "xyz is not a known location")
+	assert.Nil(t, resp)
+
+	loc, err = groupService.AddLocation(context.Background(), GroupLocationRequest{GroupName: "meow", Location: "xyz"})
+	require.Error(t, err)
+	assert.Nil(t, loc)
+
+	// the catalog is only fetched once
+	assert.Equal(t, 1, catalogRequests)
+}
+
+func TestValidateLocationCodeDisabled(t *testing.T) {
+	client := NewMockClient()
+	client.cfg = &Config{}
+
+	require.NoError(t, client.validateLocationCode(context.Background(), "xyz"))
+
+	client.cfg.ValidateLocations = true
+
+	require.NoError(t, client.validateLocationCode(context.Background(), "ams"))
+	require.Error(t, client.validateLocationCode(context.Background(), "xyz"))
+}
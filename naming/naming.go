@@ -0,0 +1,184 @@
+// Package naming maps arbitrary tenant identifiers to valid Turso database names.
+//
+// Names are built from an optional prefix, a slug of the tenant identifier and a short hash of the
+// identifier, e.g. tenant "Acme_Corp" becomes "tenant-acme-corp-" followed by 10 hash characters. The slug keeps names readable and
+// is truncated to fit the 32 character limit, the hash keeps names unique when slugs collide or are truncated.
+// Names are deterministic, the same identifier always maps to the same name, and a Registry stores the mapping
+// for reverse lookups.
+package naming
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base32"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/theopenlane/go-turso"
+)
+
+const (
+	// DefaultHashLength is the number of hash characters used when not configured
+	DefaultHashLength = 10
+	// MinHashLength is the minimum number of hash characters
+	MinHashLength = 6
+	// MaxHashLength is the maximum number of hash characters
+	MaxHashLength = 20
+
+	separator   = "-"
+	regexPrefix = "^[a-z0-9]+(-[a-z0-9]+)*$"
+)
+
+var (
+	// ErrEmptyTenantID is returned when the tenant identifier is empty
+	ErrEmptyTenantID = errors.New("tenant id is empty")
+
+	// ErrInvalidPrefix is returned when the prefix is not a valid database name prefix
+	ErrInvalidPrefix = errors.New("invalid prefix, can only contain lowercase letters, numbers and single dashes between them")
+
+	// ErrInvalidHashLength is returned when the hash length is out of range
+	ErrInvalidHashLength = fmt.Errorf("invalid hash length, must be between %d and %d", MinHashLength, MaxHashLength)
+
+	// ErrNameCollision is returned when a name is already registered to a different tenant
+	ErrNameCollision = errors.New("name collision, the name is registered to a different tenant")
+
+	// ErrTenantNotFound is returned when no tenant is registered for a name
+	ErrTenantNotFound = errors.New("tenant not found")
+
+	// ErrNoRegistry is returned when a registry operation is used on a namer without a registry
+	ErrNoRegistry = errors.New("no registry, the namer was created without a registry")
+)
+
+// hashEncoding encodes the hash with lowercase letters and digits only
+var hashEncoding = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+
+// nonSlug matches runs of characters that are not allowed in a slug
+var nonSlug = regexp.MustCompile("[^a-z0-9]+")
+
+// Config configures the names generated by a Namer
+type Config struct {
+	// Prefix is prepended to every name, e.g. tenant, no prefix is used when empty
+	Prefix string
+	// HashLength is the number of hash characters appended to every name, defaults to 10
+	HashLength int
+}
+
+// Namer generates database names for tenants
+type Namer struct {
+	prefix     string
+	hashLength int
+	registry   Registry
+}
+
+// NewNamer returns a namer with the config, the registry is used for reverse lookups and may be nil
+// when only Name is used
+func NewNamer(cfg Config, registry Registry) (*Namer, error) {
+	if cfg.HashLength == 0 {
+		cfg.HashLength = DefaultHashLength
+	}
+
+	if cfg.HashLength < MinHashLength || cfg.HashLength > MaxHashLength {
+		return nil, ErrInvalidHashLength
+	}
+
+	if cfg.Prefix != "" {
+		if match, _ := regexp.MatchString(regexPrefix, cfg.Prefix); !match {
+			return nil, ErrInvalidPrefix
+		}
+
+		// leave room for at least one slug character
+		if len(cfg.Prefix)+len(separator)*2+cfg.HashLength+1 > turso.MaxDatabaseNameLength {
+			return nil, ErrInvalidPrefix
+		}
+	}
+
+	return &Namer{
+		prefix:     cfg.Prefix,
+		hashLength: cfg.HashLength,
+		registry:   registry,
+	}, nil
+}
+
+// Name returns the database name of the tenant, the name always passes turso.ValidateDatabaseName
+func (n *Namer) Name(tenantID string) (string, error) {
+	if tenantID == "" {
+		return "", ErrEmptyTenantID
+	}
+
+	parts := []string{}
+
+	if n.prefix != "" {
+		parts = append(parts, n.prefix)
+	}
+
+	hash := n.hash(tenantID)
+
+	// the slug takes whatever room is left after the prefix, the hash and the separators
+	room := turso.MaxDatabaseNameLength - len(hash) - len(separator)
+	if n.prefix != "" {
+		room -= len(n.prefix) + len(separator)
+	}
+
+	if slug := slugify(tenantID, room); slug != "" {
+		parts = append(parts, slug)
+	}
+
+	name := strings.Join(append(parts, hash), separator)
+
+	if err := turso.ValidateDatabaseName(name); err != nil {
+		return "", err
+	}
+
+	return name, nil
+}
+
+// Register returns the database name of the tenant and records it in the registry
+// it returns ErrNameCollision when the name is already registered to a different tenant
+func (n *Namer) Register(ctx context.Context, tenantID string) (string, error) {
+	name, err := n.Name(tenantID)
+	if err != nil {
+		return "", err
+	}
+
+	if n.registry == nil {
+		return "", ErrNoRegistry
+	}
+
+	if err := n.registry.Register(ctx, name, tenantID); err != nil {
+		return "", err
+	}
+
+	return name, nil
+}
+
+// TenantID returns the tenant registered for the database name, it returns ErrTenantNotFound when
+// the name was not registered
+func (n *Namer) TenantID(ctx context.Context, name string) (string, error) {
+	if n.registry == nil {
+		return "", ErrNoRegistry
+	}
+
+	return n.registry.TenantID(ctx, name)
+}
+
+// hash returns the first hash length characters of the encoded sha256 of the tenant identifier
+func (n *Namer) hash(tenantID string) string {
+	sum := sha256.Sum256([]byte(tenantID))
+
+	return hashEncoding.EncodeToString(sum[:])[:n.hashLength]
+}
+
+// slugify lowercases the tenant identifier, replaces runs of disallowed characters with a dash and
+// truncates the result to the max length without leading or trailing dashes
+func slugify(tenantID string, maxLength int) string {
+	slug := nonSlug.ReplaceAllString(strings.ToLower(tenantID), separator)
+	slug = strings.Trim(slug, separator)
+
+	if len(slug) > maxLength {
+		slug = strings.TrimRight(slug[:maxLength], separator)
+	}
+
+	return slug
+}
@@ -0,0 +1,200 @@
+package naming
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/theopenlane/go-turso"
+)
+
+func TestName(t *testing.T) {
+	namer, err := NewNamer(Config{Prefix: "tenant"}, nil)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		tenantID string
+		slug     string
+	}{
+		{
+			name:     "simple",
+			tenantID: "acme",
+			slug:     "tenant-acme-",
+		},
+		{
+			name:     "mixed case and underscores",
+			tenantID: "Acme_Corp",
+			slug:     "tenant-acme-corp-",
+		},
+		{
+			name:     "uuid is truncated",
+			tenantID: "0D4C6B1E-5A4F-4E6B-9C1D-2B3A4C5D6E7F",
+			slug:     "tenant-0d4c6b1e-5a4f-",
+		},
+		{
+			name:     "no slug characters",
+			tenantID: "___",
+			slug:     "tenant-",
+		},
+		{
+			name:     "truncated at a dash",
+			tenantID: "abcdefghijklm_opq",
+			slug:     "tenant-abcdefghijklm-",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name, err := namer.Name(tt.tenantID)
+			require.NoError(t, err)
+			require.NoError(t, turso.ValidateDatabaseName(name))
+			assert.True(t, strings.HasPrefix(name, tt.slug), name)
+			assert.Len(t, name, len(tt.slug)+DefaultHashLength)
+
+			again, err := namer.Name(tt.tenantID)
+			require.NoError(t, err)
+			assert.Equal(t, name, again)
+		})
+	}
+
+	// identifiers with the same slug get different names
+	a, err := namer.Name("Acme_Corp")
+	require.NoError(t, err)
+
+	b, err := namer.Name("acme-corp")
+	require.NoError(t, err)
+	assert.NotEqual(t, a, b)
+
+	_, err = namer.Name("")
+	require.ErrorIs(t, err, ErrEmptyTenantID)
+}
+
+func TestNameAlwaysValid(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2)) //nolint:gosec
+
+	alphabet := []rune("abcXYZ019_-. /é🙂")
+
+	for _, cfg := range []Config{{}, {Prefix: "t"}, {Prefix: "tenant-db", HashLength: MaxHashLength}} {
+		namer, err := NewNamer(cfg, nil)
+		require.NoError(t, err)
+
+		for range 1000 {
+			id := make([]rune, r.IntN(64)+1)
+			for i := range id {
+				id[i] = alphabet[r.IntN(len(alphabet))]
+			}
+
+			name, err := namer.Name(string(id))
+			require.NoError(t, err)
+			require.NoError(t, turso.ValidateDatabaseName(name), "%q -> %q", string(id), name)
+			assert.False(t, strings.HasPrefix(name, "-") || strings.HasSuffix(name, "-") || strings.Contains(name, "--"), name)
+		}
+	}
+}
+
+func TestNewNamer(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     Config
+		wantErr error
+	}{
+		{
+			name: "defaults",
+			cfg:  Config{},
+		},
+		{
+			name: "valid prefix",
+			cfg:  Config{Prefix: "tenant-db", HashLength: 8},
+		},
+		{
+			name:    "invalid prefix",
+			cfg:     Config{Prefix: "Tenant_"},
+			wantErr: ErrInvalidPrefix,
+		},
+		{
+			name:    "prefix with trailing dash",
+			cfg:     Config{Prefix: "tenant-"},
+			wantErr: ErrInvalidPrefix,
+		},
+		{
+			name:    "prefix too long",
+			cfg:     Config{Prefix: "a-very-long-tenant-prefix"},
+			wantErr: ErrInvalidPrefix,
+		},
+		{
+			name:    "hash too short",
+			cfg:     Config{HashLength: 2},
+			wantErr: ErrInvalidHashLength,
+		},
+		{
+			name:    "hash too long",
+			cfg:     Config{HashLength: 40},
+			wantErr: ErrInvalidHashLength,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewNamer(tt.cfg, nil)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRegister(t *testing.T) {
+	ctx := context.Background()
+	registry := NewMemoryRegistry()
+
+	namer, err := NewNamer(Config{Prefix: "tenant"}, registry)
+	require.NoError(t, err)
+
+	name, err := namer.Register(ctx, "Acme_Corp")
+	require.NoError(t, err)
+
+	tenantID, err := namer.TenantID(ctx, name)
+	require.NoError(t, err)
+	assert.Equal(t, "Acme_Corp", tenantID)
+
+	// registering again is a no-op
+	again, err := namer.Register(ctx, "Acme_Corp")
+	require.NoError(t, err)
+	assert.Equal(t, name, again)
+
+	// a different tenant registered under the name collides
+	require.NoError(t, registry.Register(ctx, "tenant-other-aaaaaaaaaa", "other"))
+	require.ErrorIs(t, registry.Register(ctx, "tenant-other-aaaaaaaaaa", "Other"), ErrNameCollision)
+
+	_, err = namer.TenantID(ctx, "tenant-unknown-aaaaaaaaaa")
+	require.ErrorIs(t, err, ErrTenantNotFound)
+
+	// no registry
+	namer, err = NewNamer(Config{}, nil)
+	require.NoError(t, err)
+
+	_, err = namer.Register(ctx, "acme")
+	require.ErrorIs(t, err, ErrNoRegistry)
+
+	_, err = namer.TenantID(ctx, "acme")
+	require.ErrorIs(t, err, ErrNoRegistry)
+}
+
+func ExampleNamer_Name() {
+	namer, _ := NewNamer(Config{Prefix: "tenant"}, nil)
+
+	name, _ := namer.Name("Acme_Corp")
+
+	fmt.Println(strings.HasPrefix(name, "tenant-acme-corp-"), len(name))
+	// Output: true 27
+}
@@ -0,0 +1,54 @@
+package naming
+
+import (
+	"context"
+	"sync"
+)
+
+// Registry stores the tenant of every generated database name for reverse lookups,
+// implementations backed by a database or cache can be used to share the mapping between services
+type Registry interface {
+	// Register records the tenant of the name, registering the same tenant again is a no-op
+	// it returns ErrNameCollision when the name is registered to a different tenant
+	Register(ctx context.Context, name, tenantID string) error
+	// TenantID returns the tenant of the name, it returns ErrTenantNotFound when the name is not registered
+	TenantID(ctx context.Context, name string) (string, error)
+}
+
+// MemoryRegistry is an in-memory Registry safe for concurrent use
+type MemoryRegistry struct {
+	mu      sync.RWMutex
+	tenants map[string]string
+}
+
+// NewMemoryRegistry returns an empty in-memory registry
+func NewMemoryRegistry() *MemoryRegistry {
+	return &MemoryRegistry{tenants: map[string]string{}}
+}
+
+// Register satisfies the Registry interface
+func (r *MemoryRegistry) Register(ctx context.Context, name, tenantID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if existing, ok := r.tenants[name]; ok && existing != tenantID {
+		return ErrNameCollision
+	}
+
+	r.tenants[name] = tenantID
+
+	return nil
+}
+
+// TenantID satisfies the Registry interface
+func (r *MemoryRegistry) TenantID(ctx context.Context, name string) (string, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	tenantID, ok := r.tenants[name]
+	if !ok {
+		return "", ErrTenantNotFound
+	}
+
+	return tenantID, nil
+}
@@ -4,11 +4,16 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
+	"net/url"
+	"strconv"
+	"time"
 )
 
 const (
 	organizationEndpoint = "v1/organizations"
+	auditLogsEndpoint    = "v1/organizations/%s/audit-logs"
 )
 
 type OrganizationService service
@@ -16,6 +21,10 @@ type OrganizationService service
 type organizationService interface {
 	// ListOrganizations lists all organizations for the authorized user
 	ListOrganizations(ctx context.Context) (*[]Organization, error)
+	// ListAuditLogs lists a single page of audit logs for the organization
+	ListAuditLogs(ctx context.Context, req ListAuditLogsRequest) (*ListAuditLogsResponse, error)
+	// AuditLogs returns an iterator over all audit logs for the organization, following pages as needed
+	AuditLogs(ctx context.Context, req ListAuditLogsRequest) iter.Seq2[AuditLog, error]
 }
 
 // Organization is the struct for the Turso Organization object
@@ -31,6 +40,72 @@ type Organization struct {
 	Memory        int    `json:"memory"`
 }
 
+// AuditLogCode is the code of the action recorded in an audit log
+type AuditLogCode string
+
+const (
+	AuditLogCodeDatabaseCreate  AuditLogCode = "db-create"
+	AuditLogCodeDatabaseDelete  AuditLogCode = "db-delete"
+	AuditLogCodeInstanceCreate  AuditLogCode = "instance-create"
+	AuditLogCodeInstanceDelete  AuditLogCode = "instance-delete"
+	AuditLogCodeOrgCreate       AuditLogCode = "org-create"
+	AuditLogCodeOrgDelete       AuditLogCode = "org-delete"
+	AuditLogCodeOrgMemberAdd    AuditLogCode = "org-member-add"
+	AuditLogCodeOrgMemberRemove AuditLogCode = "org-member-rm"
+	AuditLogCodeOrgMemberLeave  AuditLogCode = "org-member-leave"
+	AuditLogCodeOrgPlanUpdate   AuditLogCode = "org-plan-update"
+	AuditLogCodeOrgSetOverages  AuditLogCode = "org-set-overages"
+	AuditLogCodeGroupCreate     AuditLogCode = "group-create"
+	AuditLogCodeGroupDelete     AuditLogCode = "group-delete"
+)
+
+// AuditLogOrigin is where the action recorded in an audit log originated from
+type AuditLogOrigin string
+
+const (
+	AuditLogOriginCLI AuditLogOrigin = "cli"
+	AuditLogOriginWeb AuditLogOrigin = "web"
+	AuditLogOriginAPI AuditLogOrigin = "api"
+)
+
+// AuditLog is the struct for the Turso AuditLog object
+type AuditLog struct {
+	// Code is the action that was performed
+	Code AuditLogCode `json:"code"`
+	// Message is a human readable description of the action
+	Message string `json:"message"`
+	// Origin is where the action was performed from
+	Origin AuditLogOrigin `json:"origin"`
+	// Author is the username of the user that performed the action
+	Author string `json:"author"`
+	// CreatedAt is the time the action was performed
+	CreatedAt time.Time `json:"created_at"`
+	// Data is the raw payload associated with the action, the shape depends on the code
+	Data json.RawMessage `json:"data"`
+}
+
+// Pagination is the struct for the Turso API pagination object
+type Pagination struct {
+	Page       int `json:"page"`
+	PageSize   int `json:"page_size"`
+	TotalPages int `json:"total_pages"`
+	TotalRows  int `json:"total_rows"`
+}
+
+// ListAuditLogsRequest is the struct for the Turso API audit logs list request
+type ListAuditLogsRequest struct {
+	// PageSize is the number of audit logs to return per page, the API default is used when not set
+	PageSize int
+	// Page is the page to return, starting at 1, the first page is used when not set
+	Page int
+}
+
+// ListAuditLogsResponse is the struct for the Turso API audit logs list response
+type ListAuditLogsResponse struct {
+	AuditLogs  []AuditLog `json:"audit_logs"`
+	Pagination Pagination `json:"pagination"`
+}
+
 // getOrganizationEndpoint returns the endpoint for the Turso API organization service
 func getOrganizationEndpoint(baseURL string) string {
 	return fmt.Sprintf("%s/%s", baseURL, organizationEndpoint)
@@ -58,3 +133,95 @@ func (s *OrganizationService) ListOrganizations(ctx context.Context) (*[]Organiz
 
 	return &out, nil
 }
+
+// getAuditLogsEndpoint returns the endpoint for the Turso API audit logs service
+func getAuditLogsEndpoint(baseURL, orgName string, req ListAuditLogsRequest) string {
+	endpoint := fmt.Sprintf("%s/%s", baseURL, fmt.Sprintf(auditLogsEndpoint, orgName))
+
+	params := url.Values{}
+
+	if req.PageSize > 0 {
+		params.Set("page_size", strconv.Itoa(req.PageSize))
+	}
+
+	if req.Page > 0 {
+		params.Set("page", strconv.Itoa(req.Page))
+	}
+
+	if len(params) == 0 {
+		return endpoint
+	}
+
+	return fmt.Sprintf("%s?%s", endpoint, params.Encode())
+}
+
+// ListAuditLogs satisfies the organizationService interface
+func (s *OrganizationService) ListAuditLogs(ctx context.Context, req ListAuditLogsRequest) (*ListAuditLogsResponse, error) {
+	if err := validateListAuditLogsRequest(req); err != nil {
+		return nil, err
+	}
+
+	endpoint := getAuditLogsEndpoint(s.client.cfg.BaseURL, s.client.cfg.OrgName, req)
+
+	resp, err := s.client.DoRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	var out ListAuditLogsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newBadRequestError("audit logs", "listing", resp.StatusCode)
+	}
+
+	return &out, nil
+}
+
+// AuditLogs satisfies the organizationService interface
+// the iterator starts at the requested page and stops after the last page or on the first error
+func (s *OrganizationService) AuditLogs(ctx context.Context, req ListAuditLogsRequest) iter.Seq2[AuditLog, error] {
+	return func(yield func(AuditLog, error) bool) {
+		if req.Page < 1 {
+			req.Page = 1
+		}
+
+		for {
+			out, err := s.ListAuditLogs(ctx, req)
+			if err != nil {
+				yield(AuditLog{}, err)
+
+				return
+			}
+
+			for _, log := range out.AuditLogs {
+				if !yield(log, nil) {
+					return
+				}
+			}
+
+			if len(out.AuditLogs) == 0 || req.Page >= out.Pagination.TotalPages {
+				return
+			}
+
+			req.Page++
+		}
+	}
+}
+
+// validateListAuditLogsRequest validates the audit logs list request
+func validateListAuditLogsRequest(req ListAuditLogsRequest) error {
+	if req.PageSize < 0 {
+		return newInvalidFieldError("page_size", "must not be negative")
+	}
+
+	if req.Page < 0 {
+		return newInvalidFieldError("page", "must not be negative")
+	}
+
+	return nil
+}
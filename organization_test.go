@@ -1,8 +1,12 @@
 package turso
 
 import (
+	"bytes"
 	"context"
+	"io"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -15,3 +19,112 @@ func TestListOrganizations(t *testing.T) {
 	require.NoError(t, err)
 	assert.Len(t, *resp, 1)
 }
+
+func TestListAuditLogs(t *testing.T) {
+	body := `{"audit_logs":[{"code":"db-create","message":"created database my-db","origin":"cli","author":"meow","created_at":"2024-01-01T10:00:00Z","data":{"name":"my-db"}}],"pagination":{"page":1,"page_size":10,"total_pages":1,"total_rows":1}}`
+	client := &Client{
+		cfg: &Config{
+			BaseURL: "http://localhost",
+			OrgName: "meow",
+		},
+		client: &MockHTTPRequestDoer{
+			Response: &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewReader([]byte(body))),
+			},
+		},
+	}
+
+	// happy path
+	orgService := OrganizationService{client: client}
+
+	resp, err := orgService.ListAuditLogs(context.Background(), ListAuditLogsRequest{PageSize: 10})
+	require.NoError(t, err)
+	require.Len(t, resp.AuditLogs, 1)
+	assert.Equal(t, AuditLogCodeDatabaseCreate, resp.AuditLogs[0].Code)
+	assert.Equal(t, AuditLogOriginCLI, resp.AuditLogs[0].Origin)
+	assert.Equal(t, "meow", resp.AuditLogs[0].Author)
+	assert.Equal(t, time.Date(2024, time.January, 1, 10, 0, 0, 0, time.UTC), resp.AuditLogs[0].CreatedAt)
+	assert.Equal(t, 1, resp.Pagination.TotalPages)
+
+	// test error
+	resp, err = orgService.ListAuditLogs(context.Background(), ListAuditLogsRequest{PageSize: -1})
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+}
+
+func TestAuditLogs(t *testing.T) {
+	pages := map[string]string{
+		"1": `{"audit_logs":[{"code":"db-create","author":"meow"},{"code":"db-delete","author":"meow"}],"pagination":{"page":1,"page_size":2,"total_pages":2,"total_rows":3}}`,
+		"2": `{"audit_logs":[{"code":"group-create","author":"woof"}],"pagination":{"page":2,"page_size":2,"total_pages":2,"total_rows":3}}`,
+	}
+
+	var requested []string
+
+	client := &Client{
+		cfg: &Config{
+			BaseURL: "http://localhost",
+			OrgName: "meow",
+		},
+		client: MockHTTPRequestDoerFunc(func(req *http.Request) (*http.Response, error) {
+			page := req.URL.Query().Get("page")
+			requested = append(requested, page)
+
+			assert.Equal(t, "2", req.URL.Query().Get("page_size"))
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewReader([]byte(pages[page]))),
+			}, nil
+		}),
+	}
+
+	orgService := OrganizationService{client: client}
+
+	var codes []AuditLogCode
+
+	for log, err := range orgService.AuditLogs(context.Background(), ListAuditLogsRequest{PageSize: 2}) {
+		require.NoError(t, err)
+
+		codes = append(codes, log.Code)
+	}
+
+	assert.Equal(t, []AuditLogCode{AuditLogCodeDatabaseCreate, AuditLogCodeDatabaseDelete, AuditLogCodeGroupCreate}, codes)
+	assert.Equal(t, []string{"1", "2"}, requested)
+
+	// stopping early should not request the next page
+	requested = nil
+
+	for range orgService.AuditLogs(context.Background(), ListAuditLogsRequest{PageSize: 2}) {
+		break
+	}
+
+	assert.Equal(t, []string{"1"}, requested)
+}
+
+func TestAuditLogsError(t *testing.T) {
+	client := &Client{
+		cfg: &Config{
+			BaseURL: "http://localhost",
+		},
+		client: &MockHTTPRequestDoer{
+			Response: &http.Response{
+				StatusCode: http.StatusUnauthorized,
+				Body:       io.NopCloser(bytes.NewReader([]byte(`{"error":"unauthorized"}`))),
+			},
+		},
+	}
+
+	orgService := OrganizationService{client: client}
+
+	count := 0
+
+	for _, err := range orgService.AuditLogs(context.Background(), ListAuditLogsRequest{}) {
+		require.Error(t, err)
+		assert.Equal(t, "error listing audit logs: 401", err.Error())
+
+		count++
+	}
+
+	assert.Equal(t, 1, count)
+}
@@ -2,7 +2,9 @@ package turso
 
 import (
 	"context"
+	"iter"
 	"net/http"
+	"time"
 )
 
 // MockHTTPRequestDoer implements the standard http.Client interface.
@@ -16,6 +18,15 @@ func (md *MockHTTPRequestDoer) Do(req *http.Request) (*http.Response, error) {
 	return md.Response, md.Error
 }
 
+// MockHTTPRequestDoerFunc implements the standard http.Client interface using a function
+// this can be used when a test needs different responses based on the request
+type MockHTTPRequestDoerFunc func(req *http.Request) (*http.Response, error)
+
+// Do implements the standard http.Client interface for MockHTTPRequestDoerFunc
+func (f MockHTTPRequestDoerFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
 // NewMockClient creates a new client for interacting with the Turso API to mock ok requests
 // this can be used to test the client without hitting the actual API an expect an 200 OK response.
 func NewMockClient() *Client {
@@ -24,17 +35,21 @@ func NewMockClient() *Client {
 	c.Database = newMockDatabaseService()
 	c.Organization = newMockOrganizationService()
 	c.DatabaseTokens = newMockDatabaseTokenService()
+	c.GroupTokens = newMockGroupTokensService()
+	c.Locations = newMockLocationService()
 
 	return c
 }
 
 type MockGroupService struct {
-	ListGroupResponse     *ListGroupResponse
-	CreateGroupResponse   *CreateGroupResponse
-	GetGroupResponse      *GetGroupResponse
-	DeleteGroupResponse   *DeleteGroupResponse
-	GroupLocationResponse *GroupLocationResponse
-	Error                 error
+	ListGroupResponse      *ListGroupResponse
+	CreateGroupResponse    *CreateGroupResponse
+	GetGroupResponse       *GetGroupResponse
+	DeleteGroupResponse    *DeleteGroupResponse
+	GroupLocationResponse  *GroupLocationResponse
+	GroupConfigResponse    *GroupConfig
+	UnarchiveGroupResponse *UnarchiveGroupResponse
+	Error                  error
 }
 
 type MockDatabaseService struct {
@@ -42,6 +57,11 @@ type MockDatabaseService struct {
 	CreateDatabaseResponse *CreateDatabaseResponse
 	GetDatabaseResponse    *GetDatabaseResponse
 	DeleteDatabaseResponse *DeleteDatabaseResponse
+	DatabaseConfigResponse *DatabaseConfig
+	DatabaseUsageResponse  *GetDatabaseUsageResponse
+	DatabaseStatsResponse  *GetDatabaseStatsResponse
+	ListInstancesResponse  *ListInstancesResponse
+	GetInstanceResponse    *GetInstanceResponse
 	Error                  error
 }
 
@@ -50,8 +70,20 @@ type MockDatabaseTokensService struct {
 	Error                       error
 }
 
+type MockGroupTokensService struct {
+	CreateGroupTokenResponse *CreateGroupTokenResponse
+	Error                    error
+}
+
+type MockLocationService struct {
+	ListLocationsResponse   *ListLocationsResponse
+	ClosestLocationResponse *ClosestLocationResponse
+	Error                   error
+}
+
 type MockOrganizationService struct {
 	ListOrganizationsResponse *[]Organization
+	ListAuditLogsResponse     *ListAuditLogsResponse
 	Error                     error
 }
 
@@ -109,6 +141,19 @@ func newMockGroupService() groupService {
 				Version:   "v0.23.7",
 			},
 		},
+		GroupConfigResponse: &GroupConfig{
+			DeleteProtection: true,
+		},
+		UnarchiveGroupResponse: &UnarchiveGroupResponse{
+			Group: Group{
+				Archived:  false,
+				Locations: []string{"lhr", "ams", "bos"},
+				Name:      "meow",
+				Primary:   "lhr",
+				UUID:      "0a28102d-6906-11ee-8553-eaa7715aeaf2",
+				Version:   "v0.23.7",
+			},
+		},
 		Error: nil,
 	}
 }
@@ -141,6 +186,48 @@ func newMockDatabaseService() databaseService {
 		DeleteDatabaseResponse: &DeleteDatabaseResponse{
 			Database: "my-db",
 		},
+		DatabaseConfigResponse: &DatabaseConfig{
+			SizeLimit:   "256mb",
+			AllowAttach: true,
+		},
+		DatabaseUsageResponse: &GetDatabaseUsageResponse{
+			Database: DatabaseUsage{
+				UUID: "0eb771dd-6906-11ee-8553-eaa7715aeaf2",
+				Total: Usage{
+					RowsRead:     100,
+					RowsWritten:  10,
+					StorageBytes: 4096,
+				},
+			},
+		},
+		DatabaseStatsResponse: &GetDatabaseStatsResponse{
+			TopQueries: []QueryStats{
+				{
+					Query:    "SELECT * FROM users",
+					RowsRead: 100,
+				},
+			},
+		},
+		ListInstancesResponse: &ListInstancesResponse{
+			Instances: []Instance{
+				{
+					UUID:     "cd831986-94e5-11ee-a6fe-7a52e1f7759a",
+					Name:     "lhr",
+					Type:     InstanceTypePrimary,
+					Region:   "lhr",
+					Hostname: "[instanceName]-[databaseName]-[organizationName].turso.io",
+				},
+			},
+		},
+		GetInstanceResponse: &GetInstanceResponse{
+			Instance: Instance{
+				UUID:     "cd831986-94e5-11ee-a6fe-7a52e1f7759a",
+				Name:     "lhr",
+				Type:     InstanceTypePrimary,
+				Region:   "lhr",
+				Hostname: "[instanceName]-[databaseName]-[organizationName].turso.io",
+			},
+		},
 		Error: nil,
 	}
 }
@@ -153,6 +240,49 @@ func newMockOrganizationService() organizationService {
 				Slug: "meow",
 			},
 		},
+		ListAuditLogsResponse: &ListAuditLogsResponse{
+			AuditLogs: []AuditLog{
+				{
+					Code:      AuditLogCodeDatabaseCreate,
+					Message:   "created database my-db",
+					Origin:    AuditLogOriginCLI,
+					Author:    "meow",
+					CreatedAt: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+			Pagination: Pagination{
+				Page:       1,
+				PageSize:   10,
+				TotalPages: 1,
+				TotalRows:  1,
+			},
+		},
+		Error: nil,
+	}
+}
+
+func newMockGroupTokensService() groupTokensService {
+	return &MockGroupTokensService{
+		CreateGroupTokenResponse: &CreateGroupTokenResponse{
+			JWT: "jwt-token",
+		},
+		Error: nil,
+	}
+}
+
+func newMockLocationService() locationService {
+	return &MockLocationService{
+		ListLocationsResponse: &ListLocationsResponse{
+			Locations: map[string]string{
+				"ams": "Amsterdam, Netherlands",
+				"bos": "Boston, Massachusetts (US)",
+				"lhr": "London, United Kingdom",
+			},
+		},
+		ClosestLocationResponse: &ClosestLocationResponse{
+			Server: "lhr",
+			Client: "lhr",
+		},
 		Error: nil,
 	}
 }
@@ -190,10 +320,54 @@ func (mg *MockGroupService) RemoveLocation(ctx context.Context, req GroupLocatio
 	return mg.GroupLocationResponse, mg.Error
 }
 
+func (mg *MockGroupService) GetGroupConfig(ctx context.Context, groupName string) (*GroupConfig, error) {
+	return mg.GroupConfigResponse, mg.Error
+}
+
+func (mg *MockGroupService) UpdateGroupConfig(ctx context.Context, req UpdateGroupConfigRequest) (*GroupConfig, error) {
+	return mg.GroupConfigResponse, mg.Error
+}
+
+func (mg *MockGroupService) TransferGroup(ctx context.Context, groupName, targetOrg string) (*Group, error) {
+	if mg.GetGroupResponse == nil {
+		return nil, mg.Error
+	}
+
+	return &mg.GetGroupResponse.Group, mg.Error
+}
+
+func (mg *MockGroupService) UnarchiveGroup(ctx context.Context, groupName string) (*UnarchiveGroupResponse, error) {
+	return mg.UnarchiveGroupResponse, mg.Error
+}
+
+func (mg *MockGroupService) UpdateGroupVersion(ctx context.Context, groupName string) error {
+	return mg.Error
+}
+
 func (md *MockDatabaseService) ListDatabases(ctx context.Context) (*ListDatabaseResponse, error) {
 	return md.ListDatabaseResponse, md.Error
 }
 
+func (md *MockDatabaseService) Databases(ctx context.Context, req ListDatabasesRequest) iter.Seq2[*Database, error] {
+	return func(yield func(*Database, error) bool) {
+		if md.Error != nil {
+			yield(nil, md.Error)
+
+			return
+		}
+
+		for _, db := range md.ListDatabaseResponse.Databases {
+			if !req.matches(db) {
+				continue
+			}
+
+			if !yield(db, nil) {
+				return
+			}
+		}
+	}
+}
+
 func (md *MockDatabaseService) CreateDatabase(ctx context.Context, req CreateDatabaseRequest) (*CreateDatabaseResponse, error) {
 	return md.CreateDatabaseResponse, md.Error
 }
@@ -206,10 +380,82 @@ func (md *MockDatabaseService) DeleteDatabase(ctx context.Context, dbName string
 	return md.DeleteDatabaseResponse, md.Error
 }
 
+func (md *MockDatabaseService) GetDatabaseConfig(ctx context.Context, dbName string) (*DatabaseConfig, error) {
+	return md.DatabaseConfigResponse, md.Error
+}
+
+func (md *MockDatabaseService) UpdateDatabaseConfig(ctx context.Context, req UpdateDatabaseConfigRequest) (*DatabaseConfig, error) {
+	return md.DatabaseConfigResponse, md.Error
+}
+
+func (md *MockDatabaseService) GetDatabaseUsage(ctx context.Context, dbName string, from, to time.Time) (*GetDatabaseUsageResponse, error) {
+	return md.DatabaseUsageResponse, md.Error
+}
+
+func (md *MockDatabaseService) GetDatabaseStats(ctx context.Context, dbName string) (*GetDatabaseStatsResponse, error) {
+	return md.DatabaseStatsResponse, md.Error
+}
+
+func (md *MockDatabaseService) ListInstances(ctx context.Context, dbName string) (*ListInstancesResponse, error) {
+	return md.ListInstancesResponse, md.Error
+}
+
+func (md *MockDatabaseService) GetInstance(ctx context.Context, dbName, instanceName string) (*GetInstanceResponse, error) {
+	return md.GetInstanceResponse, md.Error
+}
+
+func (md *MockDatabaseService) DeleteInstance(ctx context.Context, dbName, instanceName string) error {
+	return md.Error
+}
+
+func (md *MockDatabaseService) TransferDatabase(ctx context.Context, dbName, targetOrg string) (*Database, error) {
+	if md.GetDatabaseResponse == nil {
+		return nil, md.Error
+	}
+
+	return md.GetDatabaseResponse.Database, md.Error
+}
+
 func (mo *MockOrganizationService) ListOrganizations(ctx context.Context) (*[]Organization, error) {
 	return mo.ListOrganizationsResponse, mo.Error
 }
 
+func (mo *MockOrganizationService) ListAuditLogs(ctx context.Context, req ListAuditLogsRequest) (*ListAuditLogsResponse, error) {
+	return mo.ListAuditLogsResponse, mo.Error
+}
+
+func (mo *MockOrganizationService) AuditLogs(ctx context.Context, req ListAuditLogsRequest) iter.Seq2[AuditLog, error] {
+	return func(yield func(AuditLog, error) bool) {
+		if mo.Error != nil {
+			yield(AuditLog{}, mo.Error)
+
+			return
+		}
+
+		for _, log := range mo.ListAuditLogsResponse.AuditLogs {
+			if !yield(log, nil) {
+				return
+			}
+		}
+	}
+}
+
 func (md *MockDatabaseTokensService) CreateDatabaseToken(ctx context.Context, req CreateDatabaseTokenRequest) (*CreateDatabaseTokenResponse, error) {
 	return md.CreateDatabaseTokenResponse, md.Error
 }
+
+func (ml *MockLocationService) ListLocations(ctx context.Context) (*ListLocationsResponse, error) {
+	return ml.ListLocationsResponse, ml.Error
+}
+
+func (ml *MockLocationService) ClosestLocation(ctx context.Context) (*ClosestLocationResponse, error) {
+	return ml.ClosestLocationResponse, ml.Error
+}
+
+func (mg *MockGroupTokensService) CreateGroupToken(ctx context.Context, req CreateGroupTokenRequest) (*CreateGroupTokenResponse, error) {
+	return mg.CreateGroupTokenResponse, mg.Error
+}
+
+func (mg *MockGroupTokensService) InvalidateGroupTokens(ctx context.Context, groupName string) error {
+	return mg.Error
+}
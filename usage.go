@@ -0,0 +1,31 @@
+package turso
+
+// Usage is the struct for the Turso API usage object, it is shared by all usage endpoints
+type Usage struct {
+	// RowsRead is the number of rows read
+	RowsRead int64 `json:"rows_read"`
+	// RowsWritten is the number of rows written
+	RowsWritten int64 `json:"rows_written"`
+	// StorageBytes is the number of bytes stored
+	StorageBytes int64 `json:"storage_bytes"`
+	// BytesSynced is the number of bytes synced to embedded replicas
+	BytesSynced int64 `json:"bytes_synced"`
+}
+
+// InstanceUsage is the usage of a single database instance
+type InstanceUsage struct {
+	// UUID is the ID of the instance
+	UUID string `json:"uuid"`
+	// Usage is the usage of the instance
+	Usage Usage `json:"usage"`
+}
+
+// DatabaseUsage is the usage of a database, broken down per instance
+type DatabaseUsage struct {
+	// UUID is the ID of the database
+	UUID string `json:"uuid"`
+	// Instances is the usage of each instance of the database
+	Instances []InstanceUsage `json:"instances"`
+	// Total is the usage of all instances combined
+	Total Usage `json:"total"`
+}
@@ -0,0 +1,221 @@
+package turso
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"time"
+)
+
+// WaitOptions configures how a resource is polled while waiting for it to become ready
+type WaitOptions struct {
+	// Interval is the delay before the first retry, defaults to the client poll interval
+	Interval time.Duration
+	// Multiplier is applied to the interval after every poll, values of 1 or less disable backoff
+	Multiplier float64
+	// MaxInterval caps the interval when backing off, no cap when zero
+	MaxInterval time.Duration
+	// Timeout limits how long to wait in addition to the context deadline, no limit when zero
+	Timeout time.Duration
+}
+
+// GroupCondition reports whether a group is ready
+type GroupCondition func(ctx context.Context, group *Group) bool
+
+// DatabaseCondition reports whether a database is ready
+type DatabaseCondition func(ctx context.Context, db *Database) bool
+
+// HostResolver resolves a hostname, it is satisfied by *net.Resolver
+type HostResolver interface {
+	LookupHost(ctx context.Context, host string) ([]string, error)
+}
+
+// WaitTimeoutError is returned when a resource did not become ready before the context was done
+type WaitTimeoutError[T any] struct {
+	// Resource is the type of resource, e.g. group or database
+	Resource string
+	// Name is the name of the resource
+	Name string
+	// LastState is the last observed state of the resource, nil if it was never found
+	LastState *T
+	// Err is the context error
+	Err error
+}
+
+// Error returns the WaitTimeoutError in string format
+func (e *WaitTimeoutError[T]) Error() string {
+	return fmt.Sprintf("timed out waiting for %s %s to be ready: %v", e.Resource, e.Name, e.Err)
+}
+
+// Unwrap returns the context error
+func (e *WaitTimeoutError[T]) Unwrap() error {
+	return e.Err
+}
+
+// GroupNotArchived is ready when the group is not archived
+func GroupNotArchived() GroupCondition {
+	return func(_ context.Context, group *Group) bool {
+		return !group.Archived
+	}
+}
+
+// GroupHasLocations is ready when all the locations are present in the group
+func GroupHasLocations(locations ...string) GroupCondition {
+	return func(_ context.Context, group *Group) bool {
+		for _, loc := range locations {
+			if !slices.Contains(group.Locations, loc) {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+// DatabaseNotSleeping is ready when the database is not sleeping
+func DatabaseNotSleeping() DatabaseCondition {
+	return func(_ context.Context, db *Database) bool {
+		return !db.Sleeping
+	}
+}
+
+// DatabaseInRegions is ready when the database is available in all the regions
+func DatabaseInRegions(regions ...string) DatabaseCondition {
+	return func(_ context.Context, db *Database) bool {
+		for _, region := range regions {
+			if !slices.Contains(db.Regions, region) {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+// DatabaseHostnameResolves is ready when the hostname of the database resolves, net.DefaultResolver is used when nil
+func DatabaseHostnameResolves(resolver HostResolver) DatabaseCondition {
+	if resolver == nil {
+		resolver = net.DefaultResolver
+	}
+
+	return func(ctx context.Context, db *Database) bool {
+		if db.Hostname == "" {
+			return false
+		}
+
+		addrs, err := resolver.LookupHost(ctx, db.Hostname)
+
+		return err == nil && len(addrs) > 0
+	}
+}
+
+// WaitForGroup polls the group until all conditions are met, the group is waited on to not be archived
+// when no conditions are given, a group that is not found yet is polled again
+func (c *Client) WaitForGroup(ctx context.Context, groupName string, opts WaitOptions, conditions ...GroupCondition) (*Group, error) {
+	if len(conditions) == 0 {
+		conditions = []GroupCondition{GroupNotArchived()}
+	}
+
+	get := func(ctx context.Context) (*Group, error) {
+		out, err := c.Group.GetGroup(ctx, groupName)
+		if err != nil {
+			return nil, err
+		}
+
+		return &out.Group, nil
+	}
+
+	return waitFor(ctx, c, "group", groupName, opts, get, conditions)
+}
+
+// WaitForDatabase polls the database until all conditions are met, the database is only waited on to exist
+// when no conditions are given, a database that is not found yet is polled again
+func (c *Client) WaitForDatabase(ctx context.Context, dbName string, opts WaitOptions, conditions ...DatabaseCondition) (*Database, error) {
+	get := func(ctx context.Context) (*Database, error) {
+		out, err := c.Database.GetDatabase(ctx, dbName)
+		if err != nil {
+			return nil, err
+		}
+
+		return out.Database, nil
+	}
+
+	return waitFor(ctx, c, "database", dbName, opts, get, conditions)
+}
+
+// waitFor polls the resource until all conditions are met or the context is done
+func waitFor[T any, C ~func(context.Context, *T) bool](ctx context.Context, c *Client, resource, name string, opts WaitOptions,
+	get func(context.Context) (*T, error), conditions []C) (*T, error) {
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = c.pollInterval()
+	}
+
+	var last *T
+
+	for {
+		current, err := get(ctx)
+
+		switch {
+		case err == nil:
+			last = current
+
+			if conditionsMet(ctx, current, conditions) {
+				return current, nil
+			}
+		case errors.Is(err, ErrNotFound):
+			// the resource may not be visible yet right after it was created
+		case ctx.Err() != nil:
+			return nil, &WaitTimeoutError[T]{Resource: resource, Name: name, LastState: last, Err: ctx.Err()}
+		default:
+			return nil, err
+		}
+
+		timer := time.NewTimer(interval)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return nil, &WaitTimeoutError[T]{Resource: resource, Name: name, LastState: last, Err: ctx.Err()}
+		case <-timer.C:
+		}
+
+		interval = nextInterval(interval, opts)
+	}
+}
+
+// conditionsMet returns true when every condition is met
+func conditionsMet[T any, C ~func(context.Context, *T) bool](ctx context.Context, current *T, conditions []C) bool {
+	for _, cond := range conditions {
+		if !cond(ctx, current) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// nextInterval returns the interval for the next poll applying the backoff options
+func nextInterval(interval time.Duration, opts WaitOptions) time.Duration {
+	if opts.Multiplier <= 1 {
+		return interval
+	}
+
+	next := time.Duration(float64(interval) * opts.Multiplier)
+
+	if opts.MaxInterval > 0 && next > opts.MaxInterval {
+		return opts.MaxInterval
+	}
+
+	return next
+}
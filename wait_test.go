@@ -0,0 +1,181 @@
+package turso
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pollingDatabaseService returns the databases in order, one per call, repeating the last one
+// a nil database is reported as not found
+type pollingDatabaseService struct {
+	*MockDatabaseService
+	states []*Database
+	calls  int
+}
+
+func (s *pollingDatabaseService) GetDatabase(ctx context.Context, dbName string) (*GetDatabaseResponse, error) {
+	state := s.states[min(s.calls, len(s.states)-1)]
+	s.calls++
+
+	if state == nil {
+		return nil, newBadRequestError("database", "getting", http.StatusNotFound)
+	}
+
+	copied := *state
+
+	return &GetDatabaseResponse{Database: &copied}, nil
+}
+
+// pollingGroupService returns the groups in order, one per call, repeating the last one
+type pollingGroupService struct {
+	*MockGroupService
+	states []Group
+	calls  int
+}
+
+func (s *pollingGroupService) GetGroup(ctx context.Context, groupName string) (*GetGroupResponse, error) {
+	state := s.states[min(s.calls, len(s.states)-1)]
+	s.calls++
+
+	return &GetGroupResponse{Group: state}, nil
+}
+
+type mockHostResolver struct {
+	hosts map[string][]string
+}
+
+func (r *mockHostResolver) LookupHost(ctx context.Context, host string) ([]string, error) {
+	addrs, ok := r.hosts[host]
+	if !ok {
+		return nil, errors.New("no such host")
+	}
+
+	return addrs, nil
+}
+
+func newWaitClient() *Client {
+	client := NewMockClient()
+	client.cfg = &Config{PollInterval: time.Millisecond}
+
+	return client
+}
+
+func TestWaitForGroup(t *testing.T) {
+	groups := &pollingGroupService{
+		MockGroupService: newMockGroupService().(*MockGroupService),
+		states: []Group{
+			{Name: "meow", Archived: true, Locations: []string{"lhr"}},
+			{Name: "meow", Locations: []string{"lhr"}},
+			{Name: "meow", Locations: []string{"lhr", "ams"}},
+		},
+	}
+
+	client := newWaitClient()
+	client.Group = groups
+
+	group, err := client.WaitForGroup(context.Background(), "meow", WaitOptions{}, GroupNotArchived(), GroupHasLocations("lhr", "ams"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"lhr", "ams"}, group.Locations)
+	assert.Equal(t, 3, groups.calls)
+
+	// the default condition waits for the group to not be archived
+	groups.calls = 0
+
+	group, err = client.WaitForGroup(context.Background(), "meow", WaitOptions{})
+	require.NoError(t, err)
+	assert.False(t, group.Archived)
+	assert.Equal(t, 2, groups.calls)
+}
+
+func TestWaitForDatabase(t *testing.T) {
+	resolver := &mockHostResolver{hosts: map[string][]string{"my-db-meow.turso.io": {"127.0.0.1"}}}
+
+	databases := &pollingDatabaseService{
+		MockDatabaseService: newMockDatabaseService().(*MockDatabaseService),
+		states: []*Database{
+			nil,
+			{Name: "my-db", Sleeping: true},
+			{Name: "my-db", Hostname: "my-db-meow.turso.io", Regions: []string{"lhr"}},
+		},
+	}
+
+	client := newWaitClient()
+	client.Database = databases
+
+	db, err := client.WaitForDatabase(context.Background(), "my-db", WaitOptions{},
+		DatabaseNotSleeping(), DatabaseInRegions("lhr"), DatabaseHostnameResolves(resolver))
+	require.NoError(t, err)
+	assert.Equal(t, "my-db-meow.turso.io", db.Hostname)
+	assert.Equal(t, 3, databases.calls)
+}
+
+func TestWaitForDatabaseTimeout(t *testing.T) {
+	databases := &pollingDatabaseService{
+		MockDatabaseService: newMockDatabaseService().(*MockDatabaseService),
+		states: []*Database{
+			{Name: "my-db", Sleeping: true},
+		},
+	}
+
+	client := newWaitClient()
+	client.Database = databases
+
+	db, err := client.WaitForDatabase(context.Background(), "my-db", WaitOptions{Timeout: 20 * time.Millisecond}, DatabaseNotSleeping())
+	require.Error(t, err)
+	assert.Nil(t, db)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	var waitErr *WaitTimeoutError[Database]
+	require.ErrorAs(t, err, &waitErr)
+	assert.Equal(t, "database", waitErr.Resource)
+	require.NotNil(t, waitErr.LastState)
+	assert.True(t, waitErr.LastState.Sleeping)
+
+	// other errors are returned right away
+	client.Database = newMockDatabaseService()
+	client.Database.(*MockDatabaseService).Error = errors.New("boom")
+
+	db, err = client.WaitForDatabase(context.Background(), "my-db", WaitOptions{})
+	require.EqualError(t, err, "boom")
+	assert.Nil(t, db)
+}
+
+func TestNextInterval(t *testing.T) {
+	tests := []struct {
+		name     string
+		interval time.Duration
+		opts     WaitOptions
+		expected time.Duration
+	}{
+		{
+			name:     "no backoff",
+			interval: time.Second,
+			opts:     WaitOptions{},
+			expected: time.Second,
+		},
+		{
+			name:     "backoff",
+			interval: time.Second,
+			opts:     WaitOptions{Multiplier: 2},
+			expected: 2 * time.Second,
+		},
+		{
+			name:     "backoff capped",
+			interval: 4 * time.Second,
+			opts:     WaitOptions{Multiplier: 2, MaxInterval: 5 * time.Second},
+			expected: 5 * time.Second,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, nextInterval(tt.interval, tt.opts))
+		})
+	}
+}